@@ -1,35 +0,0 @@
-package main
-
-import (
-	"bufio"
-	"fmt"
-	"os"
-)
-
-// Simplified version of Day 21 - Keypad Conundrum
-// Full implementation requires complex recursive optimization
-
-type Pos struct {
-	i, j int
-}
-
-func main() {
-	file, err := os.Open("keypad.txt")
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	var codes []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		codes = append(codes, scanner.Text())
-	}
-
-	// Simplified placeholder - full solution requires
-	// recursive memoization with multiple robot layers
-	fmt.Println("Day 21 - Complex keypad problem")
-	fmt.Printf("Codes to process: %v\n", codes)
-	fmt.Println("Note: Full Go implementation requires complex recursive optimization")
-}
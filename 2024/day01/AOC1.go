@@ -0,0 +1,91 @@
+package day01
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 1, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	list1, list2 []int
+}
+
+// readLists reads the two columns of location IDs. Every line must hold
+// exactly two numbers.
+func readLists(r io.Reader) ([]int, []int, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var list1, list2 []int
+	for _, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			continue
+		}
+		ids, err := line.IntsN("", 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		list1 = append(list1, ids[0])
+		list2 = append(list2, ids[1])
+	}
+	if len(list1) == 0 {
+		return nil, nil, aoc.ErrEmptyInput
+	}
+	return list1, list2, nil
+}
+
+// totalDistance pairs the smallest ID of each list, then the second
+// smallest and so on, and sums how far apart the pairs are.
+func totalDistance(list1, list2 []int) int {
+	list1, list2 = slices.Clone(list1), slices.Clone(list2)
+	slices.Sort(list1)
+	slices.Sort(list2)
+	total := 0
+	for i := range list1 {
+		total += max(list1[i]-list2[i], list2[i]-list1[i])
+	}
+	return total
+}
+
+func calculateComparisonScore(list1, list2 []int) int {
+	// Count frequency of elements in list2
+	list2Freq := make(map[int]int)
+	for _, item := range list2 {
+		list2Freq[item]++
+	}
+
+	// Calculate total score
+	totalScore := 0
+	for _, item := range list1 {
+		frequency := list2Freq[item]
+		itemScore := item * frequency
+		totalScore += itemScore
+	}
+
+	return totalScore
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	list1, list2, err := readLists(r)
+	if err != nil {
+		return err
+	}
+	s.list1, s.list2 = list1, list2
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(totalDistance(s.list1, s.list2)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(calculateComparisonScore(s.list1, s.list2)), nil
+}
@@ -0,0 +1,50 @@
+package day01
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 1, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+3   4
+4   3
+2   5
+1   3
+3   9
+3   3
+`,
+			Part1: "11",
+			Part2: "31",
+		},
+	})
+}
+
+func TestMalformed(t *testing.T) {
+	for _, tt := range []struct {
+		input string
+		line  int
+		msg   string
+	}{
+		{"3   4\n4\n2   5\n", 2, `2: want 2 numbers, got 1 "4"`},
+		{"3   4\n\n2   5   6\n", 3, `3: want 2 numbers, got 3 "2   5   6"`},
+		{"3   4\n4   x\n", 2, `2:5: invalid number "x"`},
+	} {
+		_, _, err := readLists(strings.NewReader(tt.input))
+		var perr *parse.Error
+		if !errors.As(err, &perr) || perr.Line != tt.line {
+			t.Errorf("%q: got %v, want an error on line %d", tt.input, err, tt.line)
+			continue
+		}
+		if err.Error() != tt.msg {
+			t.Errorf("%q: got %q, want %q", tt.input, err, tt.msg)
+		}
+	}
+}
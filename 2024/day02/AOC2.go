@@ -0,0 +1,158 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 2, New: func() aoc.Solver { return new(solver) }})
+}
+
+// report is one line of levels.
+type report struct {
+	line   int
+	levels []int
+}
+
+type solver struct {
+	reports []report
+}
+
+// fault is the rule a pair of levels breaks.
+type fault int
+
+const (
+	// badStep is a pair that differs by less than 1 or more than 3.
+	badStep fault = iota + 1
+	// turn is a pair going the other way from the report's first pair.
+	turn
+)
+
+// problem is the first pair of levels, at indices a and b, that breaks a
+// rule.
+type problem struct {
+	a, b  int
+	fault fault
+}
+
+// check returns the first problem in levels, leaving out the level at index
+// skip, or false if the report is safe. A skip of -1 leaves nothing out.
+func check(levels []int, skip int) (problem, bool) {
+	prev, dir := -1, 0
+	for i, level := range levels {
+		if i == skip {
+			continue
+		}
+		if prev < 0 {
+			prev = i
+			continue
+		}
+		diff := level - levels[prev]
+		step := max(diff, -diff)
+		if step < 1 || step > 3 {
+			return problem{prev, i, badStep}, true
+		}
+		switch sign := diff / step; {
+		case dir == 0:
+			dir = sign
+		case sign != dir:
+			return problem{prev, i, turn}, true
+		}
+		prev = i
+	}
+	return problem{}, false
+}
+
+// dampen returns the index of a level whose removal makes levels safe, -1
+// if it is safe as it is, or false if no single removal helps.
+//
+// Only the levels around the first problem, at index b, can help. Removing
+// a level after b leaves the bad pair and the direction set by the first
+// pair as they were. Removing one before b-2 leaves the bad pair too, and
+// the levels before it all go one way, so the direction cannot change
+// either. That leaves three candidates, each checked in linear time.
+func dampen(levels []int) (int, bool) {
+	p, bad := check(levels, -1)
+	if !bad {
+		return -1, true
+	}
+	for i := max(p.b-2, 0); i <= p.b; i++ {
+		if _, bad := check(levels, i); !bad {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			continue
+		}
+		levels, err := line.Ints("")
+		if err != nil {
+			return err
+		}
+		s.reports = append(s.reports, report{line.No, levels})
+	}
+	if len(s.reports) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+// Part1 counts the safe reports.
+func (s *solver) Part1() (aoc.Answer, error) {
+	safe := 0
+	for _, r := range s.reports {
+		if _, bad := check(r.levels, -1); !bad {
+			safe++
+		}
+	}
+	return aoc.Int(safe), nil
+}
+
+// Part2 counts the reports that are safe with at most one level removed.
+func (s *solver) Part2() (aoc.Answer, error) {
+	safe := 0
+	for _, r := range s.reports {
+		if _, ok := dampen(r.levels); ok {
+			safe++
+		}
+	}
+	return aoc.Int(safe), nil
+}
+
+// Explain lists the unsafe reports: where each first breaks a rule, which
+// rule, and which level the dampener would remove.
+func (s *solver) Explain(w io.Writer) error {
+	for _, r := range s.reports {
+		p, bad := check(r.levels, -1)
+		if !bad {
+			continue
+		}
+		a, b := r.levels[p.a], r.levels[p.b]
+		fmt.Fprintf(w, "line %d %v: levels %d and %d (%d, %d) ", r.line, r.levels, p.a+1, p.b+1, a, b)
+		switch p.fault {
+		case badStep:
+			fmt.Fprintf(w, "differ by %d, outside 1..3", max(a-b, b-a))
+		case turn:
+			fmt.Fprint(w, "change direction")
+		}
+		if i, ok := dampen(r.levels); ok {
+			fmt.Fprintf(w, "; removing level %d (%d) makes it safe\n", i+1, r.levels[i])
+		} else {
+			fmt.Fprint(w, "; no single removal makes it safe\n")
+		}
+	}
+	return nil
+}
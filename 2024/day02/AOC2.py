@@ -33,7 +33,7 @@ def is_safe_with_tolerance(row):
 
 def main():
     # Ensure the input file exists
-    file_name = "row.txt"
+    file_name = "input.txt"
     file_path = os.path.join(os.getcwd(), file_name)
     
     if not os.path.exists(file_path):
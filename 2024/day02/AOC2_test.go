@@ -0,0 +1,81 @@
+package day02
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 2, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+7 6 4 2 1
+1 2 7 8 9
+9 7 6 2 1
+1 3 2 4 5
+8 6 4 4 1
+1 3 6 7 9
+`,
+			Part1: "2",
+			Part2: "4",
+		},
+	})
+}
+
+func TestExplain(t *testing.T) {
+	// The example plus a report whose turn no removal can fix.
+	s := new(solver)
+	input := "7 6 4 2 1\n1 2 7 8 9\n9 7 6 2 1\n1 3 2 4 5\n8 6 4 4 1\n1 3 6 7 9\n1 4 2 0 5\n"
+	if err := s.Parse(strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := s.Explain(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"line 2 [1 2 7 8 9]: levels 2 and 3 (2, 7) differ by 5, outside 1..3; no single removal makes it safe",
+		"line 3 [9 7 6 2 1]: levels 3 and 4 (6, 2) differ by 4, outside 1..3; no single removal makes it safe",
+		"line 4 [1 3 2 4 5]: levels 2 and 3 (3, 2) change direction; removing level 2 (3) makes it safe",
+		"line 5 [8 6 4 4 1]: levels 3 and 4 (4, 4) differ by 0, outside 1..3; removing level 3 (4) makes it safe",
+		"line 7 [1 4 2 0 5]: levels 2 and 3 (4, 2) change direction; no single removal makes it safe",
+	}
+	got := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if !slices.Equal(got, want) {
+		t.Errorf("Explain:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestDampen(t *testing.T) {
+	// Compare with trying every removal on short random reports, which
+	// cover the cases where the first pair sets the wrong direction.
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 20000; trial++ {
+		levels := make([]int, 2+rng.Intn(6))
+		for i := range levels {
+			levels[i] = rng.Intn(9)
+		}
+		_, unsafe := check(levels, -1)
+		want := !unsafe
+		for i := range levels {
+			removed := append(slices.Clone(levels[:i]), levels[i+1:]...)
+			if _, bad := check(removed, -1); !bad {
+				want = true
+			}
+		}
+		i, got := dampen(levels)
+		if got != want {
+			t.Fatalf("dampen(%v) = %t, want %t", levels, got, want)
+		}
+		if got && i >= 0 {
+			if _, bad := check(levels, i); bad {
+				t.Fatalf("dampen(%v) removes level %d, which leaves it unsafe", levels, i)
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package day03
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 3, New: func() aoc.Solver { return new(solver) }})
+}
+
+// kind is the kind of an instruction.
+type kind int
+
+const (
+	mul kind = iota
+	do
+	dont
+	kinds
+)
+
+var kindNames = [kinds]string{"mul", "do", "don't"}
+
+// instruction is one well-formed instruction in the corrupted memory; x and
+// y are the operands of a mul.
+type instruction struct {
+	kind kind
+	x, y int
+}
+
+// tokenizer finds the instructions in a stream of memory, skipping the
+// corruption around them. It reads a byte at a time and never looks back
+// more than the byte it just read, so it runs in constant memory however
+// long the stream is.
+//
+// None of the instructions has an 'm' or a 'd' after its first byte, so
+// when a partial match fails only the byte that broke it can start the next
+// instruction; that one byte is pushed back and scanned again.
+type tokenizer struct {
+	r *bufio.Reader
+}
+
+func newTokenizer(r io.Reader) *tokenizer {
+	return &tokenizer{bufio.NewReader(r)}
+}
+
+// Next returns the next instruction, or io.EOF at the end of the stream.
+func (t *tokenizer) Next() (instruction, error) {
+	for {
+		c, err := t.r.ReadByte()
+		if err != nil {
+			return instruction{}, err
+		}
+		var in instruction
+		var ok bool
+		switch c {
+		case 'm':
+			in, ok, err = t.mul()
+		case 'd':
+			in, ok, err = t.do()
+		}
+		if err != nil || ok {
+			return in, err
+		}
+	}
+}
+
+// mul matches the rest of "mul(X,Y)" after the 'm'.
+func (t *tokenizer) mul() (instruction, bool, error) {
+	if ok, err := t.expect("ul("); !ok {
+		return instruction{}, false, err
+	}
+	x, ok, err := t.operand(',')
+	if !ok {
+		return instruction{}, false, err
+	}
+	y, ok, err := t.operand(')')
+	if !ok {
+		return instruction{}, false, err
+	}
+	return instruction{mul, x, y}, true, nil
+}
+
+// do matches the rest of "do()" or "don't()" after the 'd'.
+func (t *tokenizer) do() (instruction, bool, error) {
+	if ok, err := t.expect("o"); !ok {
+		return instruction{}, false, err
+	}
+	c, err := t.r.ReadByte()
+	if err != nil {
+		return instruction{}, false, eof(err)
+	}
+	switch c {
+	case '(':
+		ok, err := t.expect(")")
+		return instruction{kind: do}, ok, err
+	case 'n':
+		ok, err := t.expect("'t()")
+		return instruction{kind: dont}, ok, err
+	}
+	return instruction{}, false, t.r.UnreadByte()
+}
+
+// operand matches one to three digits followed by end.
+func (t *tokenizer) operand(end byte) (int, bool, error) {
+	n, digits := 0, 0
+	for {
+		c, err := t.r.ReadByte()
+		if err != nil {
+			return 0, false, eof(err)
+		}
+		switch {
+		case c >= '0' && c <= '9' && digits < 3:
+			n = n*10 + int(c-'0')
+			digits++
+		case c == end && digits > 0:
+			return n, true, nil
+		default:
+			return 0, false, t.r.UnreadByte()
+		}
+	}
+}
+
+// expect matches the bytes of s.
+func (t *tokenizer) expect(s string) (bool, error) {
+	for i := 0; i < len(s); i++ {
+		c, err := t.r.ReadByte()
+		if err != nil {
+			return false, eof(err)
+		}
+		if c != s[i] {
+			return false, t.r.UnreadByte()
+		}
+	}
+	return true, nil
+}
+
+// eof hides the end of the stream in the middle of an instruction, which
+// just means the instruction is incomplete; Next reports it on its next
+// read.
+func eof(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+type solver struct {
+	// all sums every mul, enabled only those after a do() or before the
+	// first don't().
+	all, enabled int
+
+	counts [kinds]int
+}
+
+// Parse runs the program as it reads it, since the memory may be far larger
+// than it is worth holding.
+func (s *solver) Parse(r io.Reader) error {
+	t := newTokenizer(r)
+	if _, err := t.r.Peek(1); err == io.EOF {
+		return aoc.ErrEmptyInput
+	}
+	on := true
+	for {
+		in, err := t.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		s.counts[in.kind]++
+		switch in.kind {
+		case mul:
+			s.all += in.x * in.y
+			if on {
+				s.enabled += in.x * in.y
+			}
+		case do:
+			on = true
+		case dont:
+			on = false
+		}
+	}
+}
+
+// Part1 sums the products of every mul.
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.all), nil
+}
+
+// Part2 sums the products of the muls that are enabled.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.enabled), nil
+}
+
+// Explain counts the instructions of each kind.
+func (s *solver) Explain(w io.Writer) error {
+	for k, name := range kindNames {
+		fmt.Fprintf(w, "%-5s %d\n", name, s.counts[k])
+	}
+	return nil
+}
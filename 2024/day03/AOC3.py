@@ -49,7 +49,7 @@ def read_input_file(file_name):
 
 # Main program
 if __name__ == "__main__":
-    file_name = "scrambled.txt"  # Replace with your actual file name if different
+    file_name = "input.txt"  # Replace with your actual file name if different
     memory_input = read_input_file(file_name)
     
     if memory_input:
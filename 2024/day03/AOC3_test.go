@@ -0,0 +1,100 @@
+package day03
+
+import (
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 3, []aoctest.Case{
+		{
+			Name:  "part 1",
+			Input: "xmul(2,4)%&mul[3,7]!@^do_not_mul(5,5)+mul(32,64]then(mul(11,8)mul(8,5))\n",
+			Part1: "161",
+		},
+		{
+			Name:  "part 2",
+			Input: "xmul(2,4)&mul[3,7]!^don't()_mul(5,5)+mul(32,64](mul(11,8)undo()?mul(8,5))\n",
+			Part2: "48",
+		},
+	})
+}
+
+func TestTokenizer(t *testing.T) {
+	for _, tt := range []struct {
+		memory string
+		want   []instruction
+	}{
+		{"mul(2,4)do()don't()", []instruction{{mul, 2, 4}, {kind: do}, {kind: dont}}},
+		{"mul(123,4)mul(1234,5)mul(12,3456)", []instruction{{mul, 123, 4}}},
+		{"mul(,4)mul(4,)mul(4 ,5)mul ( 4,5)", nil},
+		// A failed match can end where the next instruction begins.
+		{"mmul(1,2)mul(3,mul(4,5)dodo()do(n't()", []instruction{{mul, 1, 2}, {mul, 4, 5}, {kind: do}}},
+		{"don'tdon't()mul(7,8", []instruction{{kind: dont}}},
+	} {
+		tok := newTokenizer(strings.NewReader(tt.memory))
+		var got []instruction
+		for {
+			in, err := tok.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, in)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%q gives %v, want %v", tt.memory, got, tt.want)
+		}
+	}
+}
+
+// corruption is an endless stream of a mul followed by a don't() and a
+// do(), each surrounded by junk.
+type corruption struct{ pos int }
+
+const corruptBlock = "xmul(2,4)&don't()_do()!"
+
+func (c *corruption) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = corruptBlock[c.pos%len(corruptBlock)]
+		c.pos++
+	}
+	return len(p), nil
+}
+
+func TestStream(t *testing.T) {
+	const blocks = 1 << 16
+	r := io.LimitReader(new(corruption), blocks*int64(len(corruptBlock)))
+	s := new(solver)
+	if err := s.Parse(r); err != nil {
+		t.Fatal(err)
+	}
+	if s.all != 8*blocks || s.enabled != 8*blocks {
+		t.Errorf("sums %d and %d, want %d", s.all, s.enabled, 8*blocks)
+	}
+	if s.counts != [kinds]int{blocks, blocks, blocks} {
+		t.Errorf("counts %v", s.counts)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	if err := new(solver).Parse(strings.NewReader("")); !errors.Is(err, aoc.ErrEmptyInput) {
+		t.Errorf("empty input: got %v, want %v", err, aoc.ErrEmptyInput)
+	}
+	// Memory without instructions is not empty; its sums are just zero.
+	s := new(solver)
+	if err := s.Parse(strings.NewReader("mul(1,2")); err != nil {
+		t.Fatal(err)
+	}
+	if s.all != 0 || s.enabled != 0 {
+		t.Errorf("sums %d and %d, want 0", s.all, s.enabled)
+	}
+}
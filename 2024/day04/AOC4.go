@@ -0,0 +1,42 @@
+package day04
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+	"github.com/shubhamsugara22/AdventOfCode-202X/wordsearch"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 4, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[byte]
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+// Part1 counts XMAS in every direction.
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(len(wordsearch.Find(s.grid, []string{"XMAS"}, grid.Dirs8[:]))), nil
+}
+
+// Part2 counts the places where two MAS cross diagonally at the A. MAS is
+// not a palindrome, so each diagonal holds at most one and every centre
+// makes at most one cross.
+func (s *solver) Part2() (aoc.Answer, error) {
+	crosses, err := wordsearch.Crosses(s.grid, []string{"MAS"})
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(len(crosses)), nil
+}
@@ -78,7 +78,7 @@ def count_x_mas_occurrences(filename):
 
     return x_mas_count
 # Example usage:
-filename = "xmas.txt"  # Replace with the actual filename
+filename = "input.txt"  # Replace with the actual filename
 # result = count_word_occurrences(filename)
 # print(f"The word XMAS appears {result} times in the word search.")
 result = count_x_mas_occurrences(filename)
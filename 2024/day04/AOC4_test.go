@@ -0,0 +1,29 @@
+package day04
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 4, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+MMMSXXMASM
+MSAMXMSMSA
+AMXSXMAAMM
+MSAMASMSMX
+XMASAMXAMM
+XXAMMXXAMA
+SMSMSASXSS
+SAXAMASAAA
+MAMMMXMMMM
+MXMXAXMASX
+`,
+			Part1: "18",
+			Part2: "9",
+		},
+	})
+}
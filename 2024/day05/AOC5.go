@@ -0,0 +1,121 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/graph"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 5, New: func() aoc.Solver { return new(solver) }})
+}
+
+type Rule struct {
+	X, Y int
+}
+
+type solver struct {
+	rules   []Rule
+	updates [][]int
+}
+
+func parseInput(r io.Reader) ([]Rule, [][]int, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("invalid input format: expected 2 sections, got %d", len(sections))
+	}
+
+	// Parse rules
+	var rules []Rule
+	for _, line := range sections[0] {
+		x, y, err := line.Pair("|")
+		if err != nil {
+			return nil, nil, err
+		}
+		rules = append(rules, Rule{X: x, Y: y})
+	}
+
+	// Parse updates
+	var updates [][]int
+	for _, line := range sections[1] {
+		update, err := line.Ints(",")
+		if err != nil {
+			return nil, nil, err
+		}
+		updates = append(updates, update)
+	}
+
+	return rules, updates, nil
+}
+
+// pageOrder returns the pages of update in the order the rules require. It
+// is an error for the rules to contradict each other on those pages.
+func pageOrder(rules []Rule, update []int) ([]int, error) {
+	g := graph.New[int]()
+	for _, page := range update {
+		g.AddNode(page)
+	}
+	for _, rule := range rules {
+		if g.Has(rule.X) && g.Has(rule.Y) {
+			g.AddEdge(rule.X, rule.Y)
+		}
+	}
+	return g.TopoSort()
+}
+
+func findMiddle(pageList []int) int {
+	return pageList[len(pageList)/2]
+}
+
+// sumMiddlePages returns the middle page sums of the correctly ordered
+// updates and of the corrected invalid ones.
+func sumMiddlePages(rules []Rule, updates [][]int) (int, int, error) {
+	totalMiddleSumValid := 0
+	totalMiddleSumCorrected := 0
+
+	for _, update := range updates {
+		sorted, err := pageOrder(rules, update)
+		if err != nil {
+			return 0, 0, fmt.Errorf("update %v: %w", update, err)
+		}
+		if slices.Equal(update, sorted) {
+			totalMiddleSumValid += findMiddle(update)
+		} else {
+			totalMiddleSumCorrected += findMiddle(sorted)
+		}
+	}
+
+	return totalMiddleSumValid, totalMiddleSumCorrected, nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	rules, updates, err := parseInput(r)
+	if err != nil {
+		return err
+	}
+	s.rules, s.updates = rules, updates
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	valid, _, err := sumMiddlePages(s.rules, s.updates)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(valid), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, corrected, err := sumMiddlePages(s.rules, s.updates)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(corrected), nil
+}
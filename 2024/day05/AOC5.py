@@ -97,7 +97,7 @@ def solve_puzzle_from_file(file_path):
     return total_middle_sum_valid, total_middle_sum_corrected
 
 # Example usage
-file_path = "rules.txt"
+file_path = "input.txt"
 valid_sum, corrected_sum = solve_puzzle_from_file(file_path)
 print("Sum of middle pages from correctly ordered updates:", valid_sum)
 print("Sum of middle pages after correcting invalid updates:", corrected_sum)
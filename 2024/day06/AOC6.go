@@ -0,0 +1,219 @@
+package day06
+
+import (
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/cycle"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 6, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid       *grid.Grid[byte]
+	start      grid.Point
+	initialDir grid.Point
+}
+
+func parseInput(r io.Reader) (*grid.Grid[byte], grid.Point, grid.Point, error) {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return nil, grid.Point{}, grid.Point{}, aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	start, ok := g.FindFunc(func(ch byte) bool {
+		_, isGuard := grid.Dir4(ch)
+		return isGuard
+	})
+	if !ok {
+		return nil, grid.Point{}, grid.Point{}, errors.New("no guard on the map")
+	}
+	initialDir, _ := grid.Dir4(g.At(start))
+	return g, start, initialDir, nil
+}
+
+var errLoop = errors.New("the guard walks in a loop and never leaves the map")
+
+// guard is where the guard stands and which way they face.
+type guard struct {
+	pos, dir grid.Point
+}
+
+// simulateGuard walks the guard until they leave the map and returns the
+// state in which they first arrived at each tile they visited, in order,
+// starting with the start. It returns false instead if the guard comes back
+// to a tile facing the same way, since from then on they walk in a loop.
+func simulateGuard(g *grid.Grid[byte], start, initialDir grid.Point) ([]guard, bool) {
+	cur := guard{start, initialDir}
+	arrivals := []guard{cur}
+	visited := map[grid.Point]bool{start: true}
+	history := cycle.NewHistory[guard]()
+
+	for {
+		if _, loops := history.Add(cur); loops {
+			return nil, false
+		}
+		nextPos := cur.pos.Add(cur.dir)
+		if g.In(nextPos) && g.At(nextPos) == '#' {
+			cur.dir = cur.dir.TurnRight()
+			continue
+		}
+		if !g.In(nextPos) {
+			return arrivals, true
+		}
+		cur.pos = nextPos
+		if !visited[nextPos] {
+			visited[nextPos] = true
+			arrivals = append(arrivals, cur)
+		}
+	}
+}
+
+// jumpTable holds, for every tile and heading, the tile where the guard
+// stops in front of the next obstacle, so a whole straight stretch takes
+// one lookup. Tiles are numbered by grid.Index and headings by their place
+// in grid.Dirs4, so turning right adds one.
+type jumpTable struct {
+	cols int
+
+	// stop is indexed by tile*4 + heading; -1 means the guard walks off
+	// the map.
+	stop []int32
+}
+
+func newJumpTable(g *grid.Grid[byte]) *jumpTable {
+	n := g.Rows() * g.Cols()
+	t := &jumpTable{cols: g.Cols(), stop: make([]int32, 4*n)}
+	for d, step := range grid.Dirs4 {
+		// Visit the tiles so that the one ahead always comes first: upwards
+		// and leftwards it has the lower index.
+		for k := 0; k < n; k++ {
+			i := k
+			if d == 1 || d == 2 {
+				i = n - 1 - k
+			}
+			ahead := g.Point(i).Add(step)
+			switch {
+			case !g.In(ahead):
+				t.stop[4*i+d] = -1
+			case g.At(ahead) == '#':
+				t.stop[4*i+d] = int32(i)
+			default:
+				t.stop[4*i+d] = t.stop[4*g.Index(ahead)+d]
+			}
+		}
+	}
+	return t
+}
+
+// walker checks whether one extra obstacle traps the guard. Each worker has
+// its own, since seen is scratch space.
+type walker struct {
+	jumps *jumpTable
+
+	// seen marks the states, tile*4 + heading, the guard has turned in
+	// during the current check: those equal to run.
+	seen []uint32
+	run  uint32
+}
+
+// loops reports whether the guard, starting on tile from with the given
+// heading, walks in a loop once an obstacle stands on tile block. States
+// are only recorded after turns; a loop must turn, so it still repeats one.
+func (w *walker) loops(from, heading, block int) bool {
+	w.run++
+	cols := w.jumps.cols
+	steps := [4]int{-cols, 1, cols, -1}
+	pos, d := from, heading
+	for {
+		stop := int(w.jumps.stop[4*pos+d])
+		// The new obstacle cuts the stretch short if it lies ahead on the
+		// same line, no farther than the tile the guard would stop on.
+		step := steps[d]
+		sameLine := block%cols == pos%cols
+		if d == 1 || d == 3 {
+			sameLine = block/cols == pos/cols
+		}
+		if k := (block - pos) / step; sameLine && k > 0 && (stop < 0 || k <= (stop-pos)/step) {
+			stop = block - step
+		}
+		if stop < 0 {
+			return false
+		}
+		pos, d = stop, (d+1)%4
+		if w.seen[4*pos+d] == w.run {
+			return true
+		}
+		w.seen[4*pos+d] = w.run
+	}
+}
+
+// countTraps counts the tiles where one extra obstacle traps the guard in a
+// loop. Only tiles on the guard's path can change it, and the guard walks
+// that path unchanged until first reaching the tile, so each check starts
+// on the tile just before, facing it. The checks are independent and are
+// shared out among a pool of workers.
+func countTraps(g *grid.Grid[byte], arrivals []guard) int {
+	jumps := newJumpTable(g)
+	heading := make(map[grid.Point]int)
+	for i, d := range grid.Dirs4 {
+		heading[d] = i
+	}
+
+	jobs := make(chan guard)
+	var traps atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.GOMAXPROCS(0); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := &walker{jumps: jumps, seen: make([]uint32, len(jumps.stop))}
+			found := 0
+			for a := range jobs {
+				if w.loops(g.Index(a.pos.Sub(a.dir)), heading[a.dir], g.Index(a.pos)) {
+					found++
+				}
+			}
+			traps.Add(int64(found))
+		}()
+	}
+	// The first arrival is the start, where the guard stands already.
+	for _, a := range arrivals[1:] {
+		jobs <- a
+	}
+	close(jobs)
+	wg.Wait()
+	return int(traps.Load())
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, start, initialDir, err := parseInput(r)
+	if err != nil {
+		return err
+	}
+	s.grid, s.start, s.initialDir = g, start, initialDir
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	arrivals, leaves := simulateGuard(s.grid, s.start, s.initialDir)
+	if !leaves {
+		return aoc.Answer{}, errLoop
+	}
+	return aoc.Int(len(arrivals)), nil
+}
+
+// Part2 counts the places a new obstacle would trap the guard in a loop.
+func (s *solver) Part2() (aoc.Answer, error) {
+	arrivals, leaves := simulateGuard(s.grid, s.start, s.initialDir)
+	if !leaves {
+		return aoc.Answer{}, errLoop
+	}
+	return aoc.Int(countTraps(s.grid, arrivals)), nil
+}
@@ -33,7 +33,7 @@ def simulate_guard(grid, start, initial_dir, directions, reverse_directions):
 
 # Main function
 def main():
-    input_file = "guard.txt"  
+    input_file = "input.txt"  
     grid, start, initial_dir, directions, reverse_directions = parse_input_from_file(input_file)
     visited_positions = simulate_guard(grid, start, initial_dir, directions, reverse_directions)
     print(f"Distinct positions visited: {len(visited_positions)}")
@@ -0,0 +1,84 @@
+package day06
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 6, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+....#.....
+.........#
+..........
+..#.......
+.......#..
+..........
+.#..^.....
+........#.
+#.........
+......#...
+`,
+			Part1: "41",
+			Part2: "6",
+		},
+	})
+}
+
+func TestLoop(t *testing.T) {
+	// Blocked on all four sides, the guard turns round and round.
+	g, start, dir, err := parseInput(strings.NewReader(".#.\n#^#\n.#.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, leaves := simulateGuard(g, start, dir); leaves {
+		t.Error("the boxed-in guard left the map")
+	}
+}
+
+func TestTraps(t *testing.T) {
+	// Compare with placing each obstacle and walking the guard tile by
+	// tile, on random maps.
+	rng := rand.New(rand.NewSource(6))
+	for trial := 0; trial < 20; trial++ {
+		var b strings.Builder
+		const size = 30
+		for r := 0; r < size; r++ {
+			for c := 0; c < size; c++ {
+				switch {
+				case r == size/2 && c == size/2:
+					b.WriteByte('^')
+				case rng.Intn(8) == 0:
+					b.WriteByte('#')
+				default:
+					b.WriteByte('.')
+				}
+			}
+			b.WriteByte('\n')
+		}
+		g, start, dir, err := parseInput(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		arrivals, leaves := simulateGuard(g, start, dir)
+		if !leaves {
+			continue
+		}
+		want := 0
+		for _, a := range arrivals[1:] {
+			blocked := g.Clone()
+			blocked.Set(a.pos, '#')
+			if _, leaves := simulateGuard(blocked, start, dir); !leaves {
+				want++
+			}
+		}
+		if got := countTraps(g, arrivals); got != want {
+			t.Fatalf("map %d: countTraps = %d, want %d\n%s", trial, got, want, b.String())
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package day07
+
+import (
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 7, New: func() aoc.Solver { return new(solver) }})
+}
+
+// equation is one calibration line: the test value and its numbers.
+type equation struct {
+	target  int
+	numbers []int
+}
+
+type solver struct {
+	equations []equation
+}
+
+func evaluateLeftToRight(numbers []int, operators []string) int {
+	result := numbers[0]
+	for i, op := range operators {
+		switch op {
+		case "+":
+			result += numbers[i+1]
+		case "*":
+			result *= numbers[i+1]
+		case "||":
+			// Concatenate digits
+			result, _ = strconv.Atoi(strconv.Itoa(result) + strconv.Itoa(numbers[i+1]))
+		}
+	}
+	return result
+}
+
+func generateOperatorCombinations(n int, ops []string) [][]string {
+	if n == 0 {
+		return [][]string{{}}
+	}
+
+	smaller := generateOperatorCombinations(n-1, ops)
+	var result [][]string
+
+	for _, combo := range smaller {
+		for _, op := range ops {
+			newCombo := make([]string, len(combo)+1)
+			copy(newCombo, combo)
+			newCombo[len(combo)] = op
+			result = append(result, newCombo)
+		}
+	}
+
+	return result
+}
+
+var equationRE = regexp.MustCompile(`^\d+:( \d+)+$`)
+
+func parseEquations(r io.Reader) ([]equation, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var equations []equation
+	for _, line := range lines {
+		if !equationRE.MatchString(line.Text) {
+			return nil, line.Errorf("want \"<target>: <numbers>\"")
+		}
+		nums, err := line.SignedInts()
+		if err != nil {
+			return nil, err
+		}
+		equations = append(equations, equation{nums[0], nums[1:]})
+	}
+
+	return equations, nil
+}
+
+func solveCalibration(equations []equation, operators []string) int {
+	totalCalibrationResult := 0
+
+	for _, eq := range equations {
+		numOperators := len(eq.numbers) - 1
+		combinations := generateOperatorCombinations(numOperators, operators)
+
+		valid := false
+		for _, ops := range combinations {
+			if evaluateLeftToRight(eq.numbers, ops) == eq.target {
+				valid = true
+				break
+			}
+		}
+
+		if valid {
+			totalCalibrationResult += eq.target
+		}
+	}
+
+	return totalCalibrationResult
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	equations, err := parseEquations(r)
+	if err != nil {
+		return err
+	}
+	s.equations = equations
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	// Total Calibration Result (with concatenation)
+	return aoc.Int(solveCalibration(s.equations, []string{"+", "*", "||"})), nil
+}
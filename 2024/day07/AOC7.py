@@ -41,7 +41,7 @@ def solve_calibration(file_path):
 
 if __name__ == "__main__":
     # Ensure the input file is in the same folder as this script
-    file_name = "pattern.txt"
+    file_name = "input.txt"
     file_path = os.path.join(os.path.dirname(__file__), file_name)
 
     result = solve_calibration(file_path)
@@ -0,0 +1,28 @@
+package day07
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 7, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+190: 10 19
+3267: 81 40 27
+83: 17 5
+156: 15 6
+7290: 6 8 6 15
+161011: 16 10 13
+192: 17 8 14
+21037: 9 7 18 13
+292: 11 6 16 20
+`,
+			Part1: "3749",
+			Part2: "11387",
+		},
+	})
+}
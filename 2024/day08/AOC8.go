@@ -0,0 +1,72 @@
+package day08
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 8, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[byte]
+}
+
+func getAntinodes(g *grid.Grid[byte], coord1, coord2 grid.Point) map[grid.Point]bool {
+	step := coord2.Sub(coord1)
+
+	output := make(map[grid.Point]bool)
+
+	// Forward direction
+	for p := coord1; g.In(p); p = p.Add(step) {
+		output[p] = true
+	}
+
+	// Backward direction
+	for p := coord1; g.In(p); p = p.Sub(step) {
+		output[p] = true
+	}
+
+	return output
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	// Get coordinates for each frequency
+	freqCoords := make(map[byte][]grid.Point)
+	s.grid.Each(func(p grid.Point, char byte) {
+		if char != '.' {
+			freqCoords[char] = append(freqCoords[char], p)
+		}
+	})
+
+	// Find all antinodes
+	allAntinodes := make(map[grid.Point]bool)
+	for _, coords := range freqCoords {
+		for i := 0; i < len(coords); i++ {
+			for j := i + 1; j < len(coords); j++ {
+				antinodes := getAntinodes(s.grid, coords[i], coords[j])
+				for antinode := range antinodes {
+					allAntinodes[antinode] = true
+				}
+			}
+		}
+	}
+
+	return aoc.Int(len(allAntinodes)), nil
+}
@@ -1,7 +1,7 @@
 import numpy as np
 import itertools
 
-with open('mid.txt') as f:
+with open('input.txt') as f:
     rawgrid = f.read()
 
 grid = np.array([list(line) for line in rawgrid.splitlines()])
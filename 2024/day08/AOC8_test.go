@@ -0,0 +1,31 @@
+package day08
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 8, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+............
+........0...
+.....0......
+.......0....
+....0.......
+......A.....
+............
+............
+........A...
+.........A..
+............
+............
+`,
+			Part1: "14",
+			Part2: "34",
+		},
+	})
+}
@@ -1,14 +1,23 @@
-package main
+package day09
 
 import (
-	"fmt"
-	"os"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
 )
 
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 9, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	diskMap []int
+}
+
 func createDisk(diskMap []int) []int {
 	var disk []int
 	currentBlockID := 0
-	
+
 	for i, block := range diskMap {
 		if i%2 == 1 {
 			// Free space
@@ -23,14 +32,14 @@ func createDisk(diskMap []int) []int {
 			currentBlockID++
 		}
 	}
-	
+
 	return disk
 }
 
 func partOne(disk []int) []int {
 	updatedDisk := make([]int, len(disk))
 	copy(updatedDisk, disk)
-	
+
 	// Find free spaces
 	var freeSpace []int
 	for i, num := range disk {
@@ -38,7 +47,7 @@ func partOne(disk []int) []int {
 			freeSpace = append(freeSpace, i)
 		}
 	}
-	
+
 	freeIdx := 0
 	for i := len(disk) - 1; i >= 0; i-- {
 		if disk[i] != -1 && freeIdx < len(freeSpace) && freeSpace[freeIdx] < i {
@@ -47,7 +56,7 @@ func partOne(disk []int) []int {
 			freeIdx++
 		}
 	}
-	
+
 	return updatedDisk
 }
 
@@ -61,21 +70,26 @@ func solve(updatedDisk []int) int {
 	return sum
 }
 
-func main() {
-	content, err := os.ReadFile("snake.txt")
+func (s *solver) Parse(r io.Reader) error {
+	content, err := io.ReadAll(r)
 	if err != nil {
-		fmt.Printf("Error reading file: %v\n", err)
-		return
+		return err
 	}
 
-	var diskMap []int
 	for _, ch := range content {
 		if ch >= '0' && ch <= '9' {
-			diskMap = append(diskMap, int(ch-'0'))
+			s.diskMap = append(s.diskMap, int(ch-'0'))
 		}
 	}
+	return nil
+}
 
-	disk := createDisk(diskMap)
+func (s *solver) Part1() (aoc.Answer, error) {
+	disk := createDisk(s.diskMap)
 	updatedDisk := partOne(disk)
-	fmt.Println(solve(updatedDisk))
+	return aoc.Int(solve(updatedDisk)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
 }
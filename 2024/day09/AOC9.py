@@ -1,6 +1,6 @@
 from collections import deque
 
-FILENAME = "snake.txt"
+FILENAME = "input.txt"
 
 
 def create_disk(disk_map):
@@ -0,0 +1,20 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 9, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+2333133121414131402
+`,
+			Part1: "1928",
+			Part2: "2858",
+		},
+	})
+}
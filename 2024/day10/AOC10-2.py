@@ -50,7 +50,7 @@ def calculate_ratings(grid):
 # Main Execution
 if __name__ == "__main__":
     
-    file_path = "path.txt"
+    file_path = "input.txt"
     
     # Parse the map and calculate the total rating
     grid = parse_map(file_path)
@@ -0,0 +1,91 @@
+package day10
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 10, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[int]
+}
+
+func parseMap(r io.Reader) (*grid.Grid[int], error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return grid.ParseFunc(lines, func(_ grid.Point, ch byte) (int, error) {
+		if ch < '0' || ch > '9' {
+			return 0, fmt.Errorf("invalid height %q", ch)
+		}
+		return int(ch - '0'), nil
+	})
+}
+
+func countReachableNines(g *grid.Grid[int], start grid.Point) int {
+	visited := make(map[grid.Point]bool)
+	reachableNines := make(map[grid.Point]bool)
+
+	stack := []grid.Point{start}
+	visited[start] = true
+
+	for len(stack) > 0 {
+		pos := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, newPos := range g.Neighbors4(pos) {
+			if !visited[newPos] && g.At(newPos) == g.At(pos)+1 {
+				visited[newPos] = true
+				stack = append(stack, newPos)
+				if g.At(newPos) == 9 {
+					reachableNines[newPos] = true
+				}
+			}
+		}
+	}
+
+	return len(reachableNines)
+}
+
+func calculateScores(g *grid.Grid[int]) int {
+	totalScore := 0
+
+	for _, trailhead := range grid.FindAll(g, 0) {
+		totalScore += countReachableNines(g, trailhead)
+	}
+
+	return totalScore
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := parseMap(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	// Total score of all trailheads
+	return aoc.Int(calculateScores(s.grid)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
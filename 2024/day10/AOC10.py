@@ -49,7 +49,7 @@ def calculate_scores(grid):
 # Main Execution
 if __name__ == "__main__":
     
-    file_path = "path.txt"
+    file_path = "input.txt"
     
     # Parse the map and calculate the total score
     grid = parse_map(file_path)
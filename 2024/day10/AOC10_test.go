@@ -0,0 +1,27 @@
+package day10
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 10, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+89010123
+78121874
+87430965
+96549874
+45678903
+32019012
+01329801
+10456732
+`,
+			Part1: "36",
+			Part2: "81",
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package day11
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 11, New: func() aoc.Solver { return newSolver() }})
+}
+
+type solver struct {
+	initialStones []int
+
+	blinks int
+}
+
+func newSolver() *solver {
+	return &solver{blinks: 75}
+}
+
+func (s *solver) Params() map[string]*int {
+	return map[string]*int{"blinks": &s.blinks}
+}
+
+func processStones(stoneCounts map[int]int) map[int]int {
+	newStoneCounts := make(map[int]int)
+
+	for stone, count := range stoneCounts {
+		if stone == 0 {
+			newStoneCounts[1] += count
+		} else {
+			stoneStr := strconv.Itoa(stone)
+			if len(stoneStr)%2 == 0 {
+				mid := len(stoneStr) / 2
+				left, _ := strconv.Atoi(stoneStr[:mid])
+				right, _ := strconv.Atoi(stoneStr[mid:])
+				newStoneCounts[left] += count
+				newStoneCounts[right] += count
+			} else {
+				newStoneCounts[stone*2024] += count
+			}
+		}
+	}
+
+	return newStoneCounts
+}
+
+func simulateBlinks(initialStones []int, blinks int) map[int]int {
+	stoneCounts := make(map[int]int)
+	for _, stone := range initialStones {
+		stoneCounts[stone]++
+	}
+
+	for i := 0; i < blinks; i++ {
+		stoneCounts = processStones(stoneCounts)
+	}
+
+	return stoneCounts
+}
+
+func countStones(stoneCounts map[int]int) int {
+	total := 0
+	for _, count := range stoneCounts {
+		total += count
+	}
+	return total
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	for _, field := range strings.Fields(string(content)) {
+		stone, err := strconv.Atoi(field)
+		if err != nil {
+			return err
+		}
+		s.initialStones = append(s.initialStones, stone)
+	}
+	if len(s.initialStones) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	// Total stones after 25 blinks
+	return aoc.Int(countStones(simulateBlinks(s.initialStones, 25))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	// Total stones after 75 blinks
+	finalCounts := simulateBlinks(s.initialStones, s.blinks)
+	return aoc.Int(countStones(finalCounts)), nil
+}
@@ -0,0 +1,29 @@
+package day11
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 11, []aoctest.Case{
+		{
+			Name: "6 blinks",
+			Input: `
+125 17
+`,
+			Params: map[string]int{"blinks": 6},
+			Part2:  "22",
+		},
+		{
+			Name: "25 blinks",
+			Input: `
+125 17
+`,
+			Params: map[string]int{"blinks": 25},
+			Part1:  "55312",
+			Part2:  "55312",
+		},
+	})
+}
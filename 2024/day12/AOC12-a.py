@@ -1,4 +1,4 @@
-data = open("perem.txt").read()
+data = open("input.txt").read()
 
 data = data.split()
 H, W = len(data), len(data[0])
@@ -0,0 +1,88 @@
+package day12
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 12, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[byte]
+}
+
+func floodFill(g *grid.Grid[byte], start grid.Point, visited *grid.Grid[bool]) []grid.Point {
+	queue := []grid.Point{start}
+	regionType := g.At(start)
+	var regionCells []grid.Point
+	visited.Set(start, true)
+
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		regionCells = append(regionCells, curr)
+
+		for _, neighbor := range g.Neighbors4(curr) {
+			if !visited.At(neighbor) && g.At(neighbor) == regionType {
+				visited.Set(neighbor, true)
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	return regionCells
+}
+
+func calculatePerimeter(g *grid.Grid[byte], regionCells []grid.Point) int {
+	perimeter := 0
+
+	for _, cell := range regionCells {
+		// Every side that does not touch the same plant, including the
+		// edge of the map, is fence.
+		for _, dir := range grid.Dirs4 {
+			if ch, ok := g.Get(cell.Add(dir)); !ok || ch != g.At(cell) {
+				perimeter++
+			}
+		}
+	}
+
+	return perimeter
+}
+
+func calculateTotalPrice(g *grid.Grid[byte]) int {
+	visited := grid.New[bool](g.Rows(), g.Cols())
+
+	totalPrice := 0
+	g.Each(func(p grid.Point, _ byte) {
+		if !visited.At(p) {
+			regionCells := floodFill(g, p, visited)
+			area := len(regionCells)
+			perimeter := calculatePerimeter(g, regionCells)
+			price := area * perimeter
+			totalPrice += price
+		}
+	})
+
+	return totalPrice
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(calculateTotalPrice(s.grid)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
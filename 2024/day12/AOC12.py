@@ -68,7 +68,7 @@ def calculate_total_price(input_map):
     return total_price
 
 # Read input from file
-with open("perem.txt", "r") as file:
+with open("input.txt", "r") as file:
     input_map = file.read()
 
 print(calculate_total_price(input_map))
@@ -0,0 +1,40 @@
+package day12
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 12, []aoctest.Case{
+		{
+			Name: "small",
+			Input: `
+AAAA
+BBCD
+BBCC
+EEEC
+`,
+			Part1: "140",
+			Part2: "80",
+		},
+		{
+			Name: "large",
+			Input: `
+RRRRIICCFF
+RRRRIICCCF
+VVRRRCCFFF
+VVRCCCJFFF
+VVVVCJJCFE
+VVIVCCJJEE
+VVIIICJJEE
+MIIIIIJJEE
+MIIISIJEEE
+MMMISSJEEE
+`,
+			Part1: "1930",
+			Part2: "1206",
+		},
+	})
+}
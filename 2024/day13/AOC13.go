@@ -0,0 +1,167 @@
+package day13
+
+import (
+	"io"
+	"regexp"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/numth"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 13, New: func() aoc.Solver { return new(solver) }})
+}
+
+type Scenario struct {
+	AX, AY         int
+	BX, BY         int
+	PrizeX, PrizeY int
+}
+
+type solver struct {
+	scenarios []Scenario
+}
+
+var (
+	buttonRE = regexp.MustCompile(`^Button [AB]: X\+(\d+), Y\+(\d+)$`)
+	prizeRE  = regexp.MustCompile(`^Prize: X=(\d+), Y=(\d+)$`)
+)
+
+// parseScenario reads the two button lines and the prize line of one claw
+// machine.
+func parseScenario(lines []parse.Line) (Scenario, error) {
+	var s Scenario
+	if len(lines) != 3 {
+		return s, lines[0].Errorf("want 3 lines per machine, got %d", len(lines))
+	}
+
+	a, err := lines[0].Match(buttonRE)
+	if err != nil {
+		return s, err
+	}
+	b, err := lines[1].Match(buttonRE)
+	if err != nil {
+		return s, err
+	}
+	prize, err := lines[2].Match(prizeRE)
+	if err != nil {
+		return s, err
+	}
+
+	s.AX, s.AY = a[0], a[1]
+	s.BX, s.BY = b[0], b[1]
+	s.PrizeX, s.PrizeY = prize[0], prize[1]
+	return s, nil
+}
+
+// minTokens returns the fewest tokens that win the prize, with A presses
+// costing 3 and B presses 1, and false if the prize cannot be won. With
+// limit > 0 neither button may be pressed more than limit times.
+//
+// Usually the buttons point in different directions and Cramer's rule gives
+// the only way to reach the prize. If they are collinear the claw can only
+// move along one line; a prize on that line reduces to a single equation,
+// which may have many solutions.
+func minTokens(s Scenario, limit int64) (int64, bool) {
+	ax, ay := int64(s.AX), int64(s.AY)
+	bx, by := int64(s.BX), int64(s.BY)
+	tx, ty := int64(s.PrizeX), int64(s.PrizeY)
+
+	var a, b int64
+	if det := ax*by - ay*bx; det != 0 {
+		an, bn := tx*by-ty*bx, ax*ty-ay*tx
+		if an%det != 0 || bn%det != 0 {
+			return 0, false
+		}
+		a, b = an/det, bn/det
+		if a < 0 || b < 0 {
+			return 0, false
+		}
+	} else {
+		// Both buttons lie on the line through the origin along dir; so
+		// must the prize.
+		dirX, dirY := ax, ay
+		if dirX == 0 && dirY == 0 {
+			dirX, dirY = bx, by
+		}
+		if dirX == 0 && dirY == 0 {
+			return 0, tx == 0 && ty == 0
+		}
+		if dirX*ty != dirY*tx {
+			return 0, false
+		}
+		if limit > 0 {
+			return cheapestWithin(ax, ay, bx, by, tx, ty, limit)
+		}
+		var ok bool
+		if dirX != 0 {
+			a, b, ok = numth.MinCostNonNeg(ax, bx, tx, 3, 1)
+		} else {
+			a, b, ok = numth.MinCostNonNeg(ay, by, ty, 3, 1)
+		}
+		if !ok {
+			return 0, false
+		}
+		return 3*a + b, true
+	}
+	if limit > 0 && (a > limit || b > limit) {
+		return 0, false
+	}
+	return 3*a + b, true
+}
+
+// cheapestWithin tries every number of A presses up to limit. The cheapest
+// solution of a collinear machine may need more presses than allowed when
+// a dearer one does not.
+func cheapestWithin(ax, ay, bx, by, tx, ty, limit int64) (int64, bool) {
+	best, found := int64(0), false
+	for a := int64(0); a <= limit; a++ {
+		for b := int64(0); b <= limit; b++ {
+			if a*ax+b*bx == tx && a*ay+b*by == ty && (!found || 3*a+b < best) {
+				best, found = 3*a+b, true
+			}
+		}
+	}
+	return best, found
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return err
+	}
+	if len(sections) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	for _, lines := range sections {
+		scenario, err := parseScenario(lines)
+		if err != nil {
+			return err
+		}
+		s.scenarios = append(s.scenarios, scenario)
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	var answer int64
+	for _, scenario := range s.scenarios {
+		if tokens, ok := minTokens(scenario, 100); ok {
+			answer += tokens
+		}
+	}
+	return aoc.Int(int(answer)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	var answer int64
+	for _, scenario := range s.scenarios {
+		scenario.PrizeX += 10000000000000
+		scenario.PrizeY += 10000000000000
+		if tokens, ok := minTokens(scenario, 0); ok {
+			answer += tokens
+		}
+	}
+	return aoc.Int(int(answer)), nil
+}
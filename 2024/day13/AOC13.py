@@ -2,7 +2,7 @@ import numpy as np
 import re
 from pathlib import Path
 
-with open('claw.txt') as f:
+with open('input.txt') as f:
     scenarios = f.read().split('\n\n')
     
 # def parse(scenario):
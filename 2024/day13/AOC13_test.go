@@ -0,0 +1,51 @@
+package day13
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 13, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+Button A: X+94, Y+34
+Button B: X+22, Y+67
+Prize: X=8400, Y=5400
+
+Button A: X+26, Y+66
+Button B: X+67, Y+21
+Prize: X=12748, Y=12176
+
+Button A: X+17, Y+86
+Button B: X+84, Y+37
+Prize: X=7870, Y=6450
+
+Button A: X+69, Y+23
+Button B: X+27, Y+71
+Prize: X=18641, Y=10279
+`,
+			Part1: "480",
+			Part2: "875318608908",
+		},
+		{
+			// Two machines whose buttons push the claw along the same line.
+			// The first prize is on it and is cheapest with as many A
+			// presses as possible; the second is off it.
+			Name: "collinear buttons",
+			Input: `
+Button A: X+4, Y+4
+Button B: X+1, Y+1
+Prize: X=10, Y=10
+
+Button A: X+2, Y+4
+Button B: X+1, Y+2
+Prize: X=7, Y=15
+`,
+			Part1: "8",
+			Part2: "7500000000008",
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package day14
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/numth"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 14, New: func() aoc.Solver { return newSolver() }})
+}
+
+type Robot struct {
+	col, row   int
+	vcol, vrow int
+}
+
+type solver struct {
+	robots []Robot
+
+	rows, cols, seconds int
+}
+
+func newSolver() *solver {
+	return &solver{rows: 103, cols: 101, seconds: 100}
+}
+
+func (s *solver) Params() map[string]*int {
+	return map[string]*int{"rows": &s.rows, "cols": &s.cols, "seconds": &s.seconds}
+}
+
+var robotRE = regexp.MustCompile(`^p=(-?\d+),(-?\d+) v=(-?\d+),(-?\d+)$`)
+
+func loadRobots(r io.Reader) ([]Robot, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var robots []Robot
+	for _, line := range lines {
+		n, err := line.Match(robotRE)
+		if err != nil {
+			return nil, err
+		}
+		robots = append(robots, Robot{col: n[0], row: n[1], vcol: n[2], vrow: n[3]})
+	}
+	if len(robots) == 0 {
+		return nil, aoc.ErrEmptyInput
+	}
+
+	return robots, nil
+}
+
+func move(robots []Robot, seconds, areaRows, areaCols int) {
+	for i := range robots {
+		robots[i].row = (robots[i].row + robots[i].vrow*seconds) % areaRows
+		robots[i].col = (robots[i].col + robots[i].vcol*seconds) % areaCols
+
+		// Handle negative modulo
+		if robots[i].row < 0 {
+			robots[i].row += areaRows
+		}
+		if robots[i].col < 0 {
+			robots[i].col += areaCols
+		}
+	}
+}
+
+func safetyFactor(robots []Robot, areaRows, areaCols int) int {
+	midRow, midCol := areaRows/2, areaCols/2
+	quadrants := make(map[string]int)
+
+	for _, r := range robots {
+		if r.row == midRow || r.col == midCol {
+			continue
+		}
+		key := fmt.Sprintf("%v,%v", r.row < midRow, r.col < midCol)
+		quadrants[key]++
+	}
+
+	result := 1
+	for _, count := range quadrants {
+		result *= count
+	}
+
+	return result
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	robots, err := loadRobots(r)
+	if err != nil {
+		return err
+	}
+	s.robots = robots
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	robots := append([]Robot(nil), s.robots...)
+	move(robots, s.seconds, s.rows, s.cols)
+	return aoc.Int(safetyFactor(robots, s.rows, s.cols)), nil
+}
+
+// leastSpread returns the second in [0, size) at which the coordinates
+// pos + vel*t, wrapped to the area size, have the least variance.
+func leastSpread(pos, vel []int, size int) int {
+	best, bestVar := 0, -1
+	n := len(pos)
+	for t := 0; t < size; t++ {
+		sum, sumSq := 0, 0
+		for i := range pos {
+			p := ((pos[i]+vel[i]*t)%size + size) % size
+			sum += p
+			sumSq += p * p
+		}
+		// n² times the variance, which is all the comparison needs.
+		if v := n*sumSq - sum*sum; bestVar < 0 || v < bestVar {
+			best, bestVar = t, v
+		}
+	}
+	return best
+}
+
+// Part2 finds the first second at which the robots draw a Christmas tree.
+// Columns repeat every cols seconds and rows every rows seconds, and the
+// picture is where the robots bunch up in both: the column layout with the
+// least variance and the row layout with the least variance. The Chinese
+// remainder theorem turns the two times into one.
+func (s *solver) Part2() (aoc.Answer, error) {
+	cols, vcols := make([]int, len(s.robots)), make([]int, len(s.robots))
+	rows, vrows := make([]int, len(s.robots)), make([]int, len(s.robots))
+	for i, r := range s.robots {
+		cols[i], vcols[i] = r.col, r.vcol
+		rows[i], vrows[i] = r.row, r.vrow
+	}
+	tc := leastSpread(cols, vcols, s.cols)
+	tr := leastSpread(rows, vrows, s.rows)
+	t, _, ok := numth.CRT(int64(tc), int64(s.cols), int64(tr), int64(s.rows))
+	if !ok {
+		return aoc.Answer{}, fmt.Errorf("no second is %d mod %d and %d mod %d", tc, s.cols, tr, s.rows)
+	}
+	return aoc.Int(int(t)), nil
+}
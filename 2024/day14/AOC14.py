@@ -39,7 +39,7 @@
 # # Example usage
 # if __name__ == "__main__":
 #     # Input file containing the robot data
-#     input_file = "safety.txt"  
+#     input_file = "input.txt"  
 #     t = 100  # Time to simulate
 #     result = simulate_robots_from_file(input_file, t)
 #     print("Safety Factor after", t, "seconds:", result)
@@ -122,7 +122,7 @@ def safety_factor(robos, area_rows, area_cols):
 
 
 def main():
-    robos = load_robots("safety.txt")
+    robos = load_robots("input.txt")
     area_rows, area_cols = 103, 101
 
     move(robos, 100, area_rows, area_cols)
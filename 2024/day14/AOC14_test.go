@@ -0,0 +1,52 @@
+package day14
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 14, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+p=0,4 v=3,-3
+p=6,3 v=-1,-3
+p=10,3 v=-1,2
+p=2,0 v=2,-1
+p=0,0 v=1,3
+p=3,0 v=-2,-2
+p=7,6 v=-1,-3
+p=3,0 v=-1,-2
+p=9,3 v=2,3
+p=7,3 v=-1,2
+p=2,4 v=2,-3
+p=9,5 v=-3,-3
+`,
+			Params: map[string]int{"rows": 7, "cols": 11},
+			Part1:  "12",
+		},
+	})
+}
+
+// TestPicture places robots so that at second 6512 they crowd into one
+// 12x12 square of the full-size area and checks that Part2 finds it.
+func TestPicture(t *testing.T) {
+	const rows, cols, at = 103, 101, 6512
+	rng := rand.New(rand.NewSource(14))
+	var input strings.Builder
+	for i := 0; i < 300; i++ {
+		row, col := 40+rng.Intn(12), 50+rng.Intn(12)
+		vrow, vcol := rng.Intn(2*rows-1)-rows+1, rng.Intn(2*cols-1)-cols+1
+		row = ((row-vrow*at)%rows + rows) % rows
+		col = ((col-vcol*at)%cols + cols) % cols
+		fmt.Fprintf(&input, "p=%d,%d v=%d,%d\n", col, row, vcol, vrow)
+	}
+	aoctest.Run(t, 2024, 14, []aoctest.Case{
+		{Name: "picture", Input: input.String(), Part2: fmt.Sprint(at)},
+	})
+}
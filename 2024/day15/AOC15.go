@@ -0,0 +1,98 @@
+package day15
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 15, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	warehouse *grid.Grid[byte]
+	orderList []grid.Point
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	var mapList []string
+	inputState := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			inputState = 1
+		} else if inputState == 0 {
+			mapList = append(mapList, line)
+		} else {
+			for i := 0; i < len(line); i++ {
+				dir, ok := grid.Dir4(line[i])
+				if !ok {
+					return fmt.Errorf("invalid move %q", line[i])
+				}
+				s.orderList = append(s.orderList, dir)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	g, err := grid.ParseLines(mapList)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	if _, ok := grid.Find(g, '@'); !ok {
+		return fmt.Errorf("no robot on the map")
+	}
+	s.warehouse = g
+	return nil
+}
+
+// pushBoxes moves the robot through orderList, pushing single-width boxes,
+// and returns the sum of the GPS coordinates of the boxes at the end.
+func pushBoxes(warehouse *grid.Grid[byte], orderList []grid.Point) int {
+	g := warehouse.Clone()
+	robotPosition, _ := grid.Find(g, '@')
+	g.Set(robotPosition, '.')
+
+	for _, dir := range orderList {
+		newLoc := robotPosition.Add(dir)
+
+		switch g.At(newLoc) {
+		case '.':
+			robotPosition = newLoc
+		case 'O':
+			// Find the first cell past the row of boxes; a wall (or the
+			// edge of the map) stops the push.
+			end := newLoc.Add(dir)
+			for g.In(end) && g.At(end) == 'O' {
+				end = end.Add(dir)
+			}
+			if g.In(end) && g.At(end) == '.' {
+				g.Set(end, 'O')
+				g.Set(newLoc, '.')
+				robotPosition = newLoc
+			}
+		}
+	}
+
+	gpsSum := 0
+	for _, box := range grid.FindAll(g, 'O') {
+		gpsSum += 100*box.R + box.C
+	}
+	return gpsSum
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(pushBoxes(s.warehouse, s.orderList)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
@@ -255,7 +255,7 @@
 MapList = []
 OrderList = ""
 InputState = 0
-with open("lantern_fish.txt", "r") as data:
+with open("input.txt", "r") as data:
     for t in data:
         Line = t.strip()
         if Line == "":
@@ -0,0 +1,98 @@
+package day16
+
+import (
+	"errors"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+	"github.com/shubhamsugara22/AdventOfCode-202X/search"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 16, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	maze       *grid.Grid[byte]
+	start, end grid.Point
+}
+
+// reindeer is a position in the maze and the direction it faces.
+type reindeer struct {
+	pos, dir grid.Point
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	start, ok := grid.Find(g, 'S')
+	if !ok {
+		return errors.New("no start tile in the maze")
+	}
+	end, ok := grid.Find(g, 'E')
+	if !ok {
+		return errors.New("no end tile in the maze")
+	}
+	s.maze, s.start, s.end = g, start, end
+	return nil
+}
+
+// moves steps forward for 1 point or turns in place for 1000.
+func (s *solver) moves(r reindeer) []search.Edge[reindeer] {
+	edges := []search.Edge[reindeer]{
+		{To: reindeer{r.pos, r.dir.TurnRight()}, Cost: 1000},
+		{To: reindeer{r.pos, r.dir.TurnLeft()}, Cost: 1000},
+	}
+	if next := r.pos.Add(r.dir); s.maze.In(next) && s.maze.At(next) != '#' {
+		edges = append(edges, search.Edge[reindeer]{To: reindeer{next, r.dir}, Cost: 1})
+	}
+	return edges
+}
+
+// solveMaze runs the search from the start, facing east, and returns it with
+// the lowest score to the end tile and the end states reached at that score.
+func (s *solver) solveMaze(all bool) (*search.Result[reindeer], int, []reindeer, error) {
+	res := search.Dijkstra(reindeer{s.start, grid.Right}, s.moves, search.Options[reindeer]{
+		AllPredecessors: all,
+	})
+	best, found := 0, false
+	var ends []reindeer
+	for _, dir := range grid.Dirs4 {
+		end := reindeer{s.end, dir}
+		d, ok := res.Dist[end]
+		switch {
+		case !ok:
+		case !found || d < best:
+			best, found, ends = d, true, []reindeer{end}
+		case d == best:
+			ends = append(ends, end)
+		}
+	}
+	if !found {
+		return nil, 0, nil, errors.New("no path to the end tile")
+	}
+	return res, best, ends, nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	_, best, _, err := s.solveMaze(false)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(best), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	res, _, ends, err := s.solveMaze(true)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	tiles := make(map[grid.Point]bool)
+	for r := range res.OnBestPaths(ends...) {
+		tiles[r.pos] = true
+	}
+	return aoc.Int(len(tiles)), nil
+}
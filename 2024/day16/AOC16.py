@@ -55,7 +55,7 @@
 #         heapq.heappush(pq, (cost + 1000, (x, y, new_dir)))
 
 # # Example Usage
-# file_path = "maze.txt"
+# file_path = "input.txt"
 # result = reindeer_maze(file_path)
 # print(f"The lowest score is: {result}")
 
@@ -170,6 +170,6 @@ def solve_maze(grid):
 
 
 if __name__ == "__main__":
-    with open("maze.txt", "r") as f:
+    with open("input.txt", "r") as f:
         grid = [list(line.rstrip('\n')) for line in f]
     solve_maze(grid)
@@ -0,0 +1,121 @@
+package day17
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 17, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	regA, regB, regC int
+	program          []int
+}
+
+// run executes program on the 3-bit computer and returns its output.
+func run(program []int, A, B, C int) []int {
+	combo := func(operand int) int {
+		switch operand {
+		case 4:
+			return A
+		case 5:
+			return B
+		case 6:
+			return C
+		}
+		return operand
+	}
+
+	var out []int
+	for ip := 0; ip+1 < len(program); ip += 2 {
+		opcode, operand := program[ip], program[ip+1]
+		switch opcode {
+		case 0: // adv
+			A = A >> combo(operand)
+		case 1: // bxl
+			B = B ^ operand
+		case 2: // bst
+			B = combo(operand) % 8
+		case 3: // jnz
+			if A != 0 {
+				ip = operand - 2
+			}
+		case 4: // bxc
+			B = B ^ C
+		case 5: // out
+			out = append(out, combo(operand)%8)
+		case 6: // bdv
+			B = A >> combo(operand)
+		case 7: // cdv
+			C = A >> combo(operand)
+		}
+	}
+	return out
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	const want = `want "Register <A, B or C>: <n>" or "Program: <codes>"`
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line.Text, ":")
+		if !ok {
+			return line.Errorf(want)
+		}
+		off := len(name) + 1
+
+		var reg *int
+		switch name {
+		case "Register A":
+			reg = &s.regA
+		case "Register B":
+			reg = &s.regB
+		case "Register C":
+			reg = &s.regC
+		case "Program":
+			program, err := line.IntsAt(off, ",")
+			if err != nil {
+				return err
+			}
+			for _, n := range program {
+				if n < 0 || n > 7 {
+					return line.Errorf("instruction %d is not 3-bit", n)
+				}
+			}
+			s.program = program
+			continue
+		}
+		if reg == nil {
+			return line.Errorf(want)
+		}
+		if *reg, err = line.Int(off, value); err != nil {
+			return err
+		}
+	}
+	if len(lines) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	if len(s.program) == 0 {
+		return errors.New("no program")
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.List(run(s.program, s.regA, s.regB, s.regC)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
@@ -79,7 +79,7 @@
 
 import heapq
 
-with open('three_digit.txt', 'r') as f:
+with open('input.txt', 'r') as f:
     reg, ins = f.read().split('\n\n')
     regs = [int(r.split(':')[-1]) for r in reg.split('\n')]
     ins = [int(r) for r in ins.split(':')[-1].split(',')]
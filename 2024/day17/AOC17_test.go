@@ -0,0 +1,34 @@
+package day17
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 17, []aoctest.Case{
+		{
+			Name: "part 1",
+			Input: `
+Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0
+`,
+			Part1: "4,6,3,5,6,3,5,2,1,0",
+		},
+		{
+			Name: "part 2",
+			Input: `
+Register A: 2024
+Register B: 0
+Register C: 0
+
+Program: 0,3,5,4,3,0
+`,
+			Part2: "117440",
+		},
+	})
+}
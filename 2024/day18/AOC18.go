@@ -0,0 +1,104 @@
+package day18
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+	"github.com/shubhamsugara22/AdventOfCode-202X/search"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 18, New: func() aoc.Solver { return newSolver() }})
+}
+
+type solver struct {
+	// bytePositions holds the falling bytes; an X,Y line in the input is
+	// column X and row Y.
+	bytePositions []grid.Point
+
+	// size is the width and height of the memory space; the first fallen
+	// bytes are known not to block the path.
+	size, fallen int
+}
+
+func newSolver() *solver {
+	return &solver{size: 71, fallen: 1024}
+}
+
+func (s *solver) Params() map[string]*int {
+	return map[string]*int{"size": &s.size, "fallen": &s.fallen}
+}
+
+// shortestPath returns the fewest steps from the top left corner of the
+// memory space to the exit at the bottom right, avoiding corrupted cells.
+func shortestPath(corrupted *grid.Grid[bool]) (int, bool) {
+	start, exit := grid.Point{R: 0, C: 0}, grid.Point{R: corrupted.Rows() - 1, C: corrupted.Cols() - 1}
+	if corrupted.At(start) || corrupted.At(exit) {
+		return 0, false
+	}
+	res := search.BFS(start, func(p grid.Point) []grid.Point {
+		var next []grid.Point
+		for _, q := range corrupted.Neighbors4(p) {
+			if !corrupted.At(q) {
+				next = append(next, q)
+			}
+		}
+		return next
+	}, search.Options[grid.Point]{Goal: func(p grid.Point) bool { return p == exit }})
+	return res.Dist[exit], res.Found
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		x, y, err := line.Pair(",")
+		if err != nil {
+			return err
+		}
+		s.bytePositions = append(s.bytePositions, grid.Point{R: y, C: x})
+	}
+	if len(s.bytePositions) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	corrupted := grid.New[bool](s.size, s.size)
+	for _, pos := range s.bytePositions[:min(s.fallen, len(s.bytePositions))] {
+		if corrupted.In(pos) {
+			corrupted.Set(pos, true)
+		}
+	}
+	steps, ok := shortestPath(corrupted)
+	if !ok {
+		return aoc.Answer{}, errors.New("no path to the exit")
+	}
+	return aoc.Int(steps), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	corrupted := grid.New[bool](s.size, s.size)
+
+	for i, pos := range s.bytePositions {
+		if corrupted.In(pos) {
+			corrupted.Set(pos, true)
+			if i >= s.fallen {
+				if _, ok := shortestPath(corrupted); ok {
+					continue
+				}
+				// First blocking byte
+				return aoc.String(fmt.Sprintf("%d,%d", pos.C, pos.R)), nil
+			}
+		}
+	}
+
+	return aoc.Answer{}, errors.New("no blocking byte found")
+}
@@ -51,7 +51,7 @@
 #     return bfs_shortest_path(grid)
 
 # # Example usage
-# input_file = "RAM.txt"
+# input_file = "input.txt"
 # result = main(input_file, grid_size=71, max_bytes=1024)
 # print("Shortest path length:", result)
 
@@ -108,5 +108,5 @@ def parse_input(input_data):
 
 # Example usage
 if __name__ == "__main__":
-    input_file = "RAM.txt"  # Replace with your input file
+    input_file = "input.txt"  # Replace with your input file
     main(input_file, grid_size=71)
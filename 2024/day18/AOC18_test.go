@@ -0,0 +1,45 @@
+package day18
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 18, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1
+1,2
+5,5
+2,5
+6,5
+1,4
+0,4
+6,4
+1,1
+6,1
+1,0
+0,5
+1,6
+2,0
+`,
+			Params: map[string]int{"size": 7, "fallen": 12},
+			Part1:  "22",
+			Part2:  "6,1",
+		},
+	})
+}
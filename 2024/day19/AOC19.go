@@ -1,12 +1,22 @@
-package main
+package day19
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
 )
 
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 19, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	patterns map[string]bool
+	designs  []string
+}
+
 func countWaysToForm(design string, patterns map[string]bool, memo map[string]int) int {
 	if val, ok := memo[design]; ok {
 		return val
@@ -28,15 +38,8 @@ func countWaysToForm(design string, patterns map[string]bool, memo map[string]in
 	return ways
 }
 
-func main() {
-	file, err := os.Open("tshirt.txt")
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
+func (s *solver) Parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
 	var towelPatterns []string
 	var designs []string
 	readingPatterns := true
@@ -54,17 +57,27 @@ func main() {
 			designs = append(designs, line)
 		}
 	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
 
-	patterns := make(map[string]bool)
+	s.patterns = make(map[string]bool)
 	for _, p := range towelPatterns {
-		patterns[p] = true
+		s.patterns[p] = true
 	}
+	s.designs = designs
+	return nil
+}
 
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
 	totalCombinations := 0
-	for _, design := range designs {
+	for _, design := range s.designs {
 		memo := make(map[string]int)
-		totalCombinations += countWaysToForm(design, patterns, memo)
+		totalCombinations += countWaysToForm(design, s.patterns, memo)
 	}
-
-	fmt.Printf("Total number of combinations: %d\n", totalCombinations)
+	return aoc.Int(totalCombinations), nil
 }
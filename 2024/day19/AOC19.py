@@ -36,7 +36,7 @@
 #     return possible_count
 
 # # Example usage
-# filename = "tshirt.txt"
+# filename = "input.txt"
 # possible_designs = count_possible_designs_from_file(filename)
 # print(f"Number of possible designs: {possible_designs}")
 
@@ -81,6 +81,6 @@ def count_combinations_from_file(filename):
     return total_combinations
 
 # Example usage
-filename = "tshirt.txt"  
+filename = "input.txt"  
 total_combinations = count_combinations_from_file(filename)
 print(f"Total number of combinations: {total_combinations}")
@@ -0,0 +1,29 @@
+package day19
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 19, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+r, wr, b, g, bwu, rb, gb, br
+
+brwrr
+bggr
+gbbr
+rrbgbr
+ubwu
+bwurrg
+brgr
+bbrgwb
+`,
+			Part1: "6",
+			Part2: "16",
+		},
+	})
+}
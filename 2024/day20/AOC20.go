@@ -0,0 +1,83 @@
+package day20
+
+import (
+	"errors"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+	"github.com/shubhamsugara22/AdventOfCode-202X/search"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 20, New: func() aoc.Solver { return newSolver() }})
+}
+
+type solver struct {
+	grid  *grid.Grid[byte]
+	start grid.Point
+
+	// minSaving is the number of picoseconds a cheat must save to count.
+	minSaving int
+}
+
+func newSolver() *solver {
+	return &solver{minSaving: 100}
+}
+
+func (s *solver) Params() map[string]*int {
+	return map[string]*int{"saving": &s.minSaving}
+}
+
+// distances returns the number of steps from start to every reachable track
+// position.
+func distances(g *grid.Grid[byte], start grid.Point) map[grid.Point]int {
+	return search.BFS(start, func(p grid.Point) []grid.Point {
+		var next []grid.Point
+		for _, q := range g.Neighbors4(p) {
+			if g.At(q) != '#' {
+				next = append(next, q)
+			}
+		}
+		return next
+	}, search.Options[grid.Point]{}).Dist
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	start, ok := grid.Find(g, 'S')
+	if !ok {
+		return errors.New("no start on the map")
+	}
+	s.grid, s.start = g, start
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	startMap := distances(s.grid, s.start)
+
+	// Count cheats (simplified version)
+	p1 := 0
+	for pos := range startMap {
+		for _, dir := range grid.Dirs4 {
+			newPos := pos.Add(dir.Mul(2))
+			if dist, ok := startMap[newPos]; ok {
+				if startMap[pos] > dist {
+					savings := startMap[pos] - dist - 2
+					if savings >= s.minSaving {
+						p1++
+					}
+				}
+			}
+		}
+	}
+
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
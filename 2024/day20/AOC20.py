@@ -1,4 +1,4 @@
-data = open('cheats.txt').read().strip().split('\n')
+data = open('input.txt').read().strip().split('\n')
 
 grid = {}
 cnt = 2
@@ -0,0 +1,42 @@
+package day21
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+// Simplified version of Day 21 - Keypad Conundrum
+// Full implementation requires complex recursive optimization, so both
+// parts still report aoc.ErrNotImplemented; see AOC21.py.
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 21, New: func() aoc.Solver { return new(solver) }})
+}
+
+type Pos struct {
+	i, j int
+}
+
+type solver struct {
+	codes []string
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		s.codes = append(s.codes, scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// Simplified placeholder - full solution requires
+// recursive memoization with multiple robot layers
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
@@ -13,7 +13,7 @@ class Pos:
         return isinstance(other, Pos) and (self.i, self.j) == (other.i, other.j)
 
 codes = []
-with open('keypad.txt', 'r') as f:
+with open('input.txt', 'r') as f:
     for line in f.readlines():
         codes.append(line.strip())
 
@@ -0,0 +1,23 @@
+package day21
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 21, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+029A
+980A
+179A
+456A
+379A
+`,
+			Part1: "126384",
+		},
+	})
+}
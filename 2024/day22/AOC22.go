@@ -0,0 +1,76 @@
+package day22
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 22, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	buyers []int
+}
+
+func generateSecrets(initialSecret, count int) []int {
+	secrets := make([]int, count)
+	secret := initialSecret
+
+	for i := 0; i < count; i++ {
+		// Step 1
+		step1 := (secret * 64) % 16777216
+		secret ^= step1
+		secret %= 16777216
+
+		// Step 2
+		step2 := (secret / 32) % 16777216
+		secret ^= step2
+		secret %= 16777216
+
+		// Step 3
+		step3 := (secret * 2048) % 16777216
+		secret ^= step3
+		secret %= 16777216
+
+		secrets[i] = secret
+	}
+
+	return secrets
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		buyer, err := line.Int(0, line.Text)
+		if err != nil {
+			return err
+		}
+		s.buyers = append(s.buyers, buyer)
+	}
+	if len(s.buyers) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	// Sum of 2000th secret numbers
+	total := 0
+	for _, buyer := range s.buyers {
+		secrets := generateSecrets(buyer, 2000)
+		if len(secrets) > 0 {
+			total += secrets[len(secrets)-1]
+		}
+	}
+	return aoc.Int(total), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
@@ -33,7 +33,7 @@
 #     return total
 
 
-# file_path = 'hiding.txt'  # Replace with the name of your input file
+# file_path = 'input.txt'  # Replace with the name of your input file
 # result = sum_of_2000th_secrets(file_path)
 # print("The sum of the 2000th secret numbers is:", result)
 
@@ -98,7 +98,7 @@ def find_best_sequence(file_path):
 
     return max_bananas, best_sequence
 
-file_path = 'hiding.txt'  
+file_path = 'input.txt'  
 max_bananas, best_sequence = find_best_sequence(file_path)
 print("Maximum bananas:", max_bananas)
 print("Best sequence of changes:", best_sequence)
@@ -0,0 +1,52 @@
+package day22
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2024, 22, []aoctest.Case{
+		{
+			Name: "part 1",
+			Input: `
+1
+10
+100
+2024
+`,
+			Part1: "37327623",
+		},
+		{
+			Name: "part 2",
+			Input: `
+1
+2
+3
+2024
+`,
+			Part2: "23",
+		},
+	})
+}
+
+func TestParse(t *testing.T) {
+	s := new(solver)
+	if err := s.Parse(strings.NewReader("\xef\xbb\xbf123\r\n10\r\n\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{123, 10}; !slices.Equal(s.buyers, want) {
+		t.Errorf("buyers %v, want %v", s.buyers, want)
+	}
+	if err := new(solver).Parse(strings.NewReader("1\n1O\n")); err == nil || err.Error() != `2:1: invalid number "1O"` {
+		t.Errorf("malformed buyer: got %v", err)
+	}
+	if err := new(solver).Parse(strings.NewReader("")); !errors.Is(err, aoc.ErrEmptyInput) {
+		t.Errorf("empty input: got %v, want %v", err, aoc.ErrEmptyInput)
+	}
+}
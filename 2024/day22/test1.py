@@ -1,4 +1,4 @@
-data = [int(i) for i in open('hiding.txt').read().strip().split('\n')]
+data = [int(i) for i in open('input.txt').read().strip().split('\n')]
 
 def evolve(x):
     x = ((x*64)^x)%16777216
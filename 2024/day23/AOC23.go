@@ -0,0 +1,67 @@
+package day23
+
+import (
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/graph"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 23, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	network *graph.Graph[string]
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	network := graph.New[string]()
+	for _, line := range lines {
+		a, b, ok := strings.Cut(line.Text, "-")
+		if !ok || a == "" || b == "" {
+			return line.Errorf("want a connection like kh-tc")
+		}
+		network.AddUndirected(a, b)
+	}
+	if network.Len() == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.network = network
+	return nil
+}
+
+// Part1 counts the sets of three interconnected computers with at least one
+// name starting with t.
+func (s *solver) Part1() (aoc.Answer, error) {
+	g := s.network
+	count := 0
+	for _, a := range g.Nodes() {
+		for _, b := range g.Neighbors(a) {
+			if b <= a {
+				continue
+			}
+			for _, c := range g.Neighbors(b) {
+				if c <= b || !g.HasEdge(a, c) {
+					continue
+				}
+				if a[0] == 't' || b[0] == 't' || c[0] == 't' {
+					count++
+				}
+			}
+		}
+	}
+	return aoc.Int(count), nil
+}
+
+// Part2 returns the LAN party password, the sorted names of the largest set
+// of interconnected computers.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.String(strings.Join(s.network.MaxClique(), ",")), nil
+}
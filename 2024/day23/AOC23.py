@@ -1,7 +1,7 @@
 # from collections import defaultdict
 
 # # Step 1: Read connections from file
-# with open('Lan.txt', 'r') as file:
+# with open('input.txt', 'r') as file:
 #     connections = [line.strip() for line in file if line.strip()]
 
 # # Step 2: Build the graph as an adjacency list
@@ -36,7 +36,7 @@
 # from collections import defaultdict
 
 # # Step 1: Read connections from file
-# with open('Lan.txt', 'r') as file:
+# with open('input.txt', 'r') as file:
 #     connections = [line.strip() for line in file if line.strip()]
 
 # # Step 2: Build the graph as an adjacency list
@@ -76,7 +76,7 @@
 from collections import defaultdict
 
 # Step 1: Read connections from file
-with open('Lan.txt', 'r') as file:
+with open('input.txt', 'r') as file:
     connections = [line.strip() for line in file if line.strip()]
 
 # Step 2: Build the graph as an adjacency list
@@ -0,0 +1,128 @@
+package day24
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 24, New: func() aoc.Solver { return new(solver) }})
+}
+
+// Simplified Day 24 - Logic gate simulation
+// Full part 2 requires complex swap detection logic
+
+type solver struct {
+	initial map[string]int
+	gates   []string
+}
+
+var wireRE = regexp.MustCompile(`^(\w+): ([01])$`)
+
+func (s *solver) Parse(r io.Reader) error {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return err
+	}
+	if len(sections) != 2 {
+		return fmt.Errorf("want initial wire values and gates, got %d sections", len(sections))
+	}
+
+	wireValues := make(map[string]int)
+	for _, line := range sections[0] {
+		m := wireRE.FindStringSubmatchIndex(line.Text)
+		if m == nil {
+			return line.Errorf("want \"<wire>: <0 or 1>\"")
+		}
+		value, err := line.Int(m[4], line.Text[m[4]:m[5]])
+		if err != nil {
+			return err
+		}
+		wireValues[line.Text[m[2]:m[3]]] = value
+	}
+
+	var gates []string
+	for _, line := range sections[1] {
+		gates = append(gates, line.Text)
+	}
+	s.initial, s.gates = wireValues, gates
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	wireValues := make(map[string]int, len(s.initial))
+	for wire, value := range s.initial {
+		wireValues[wire] = value
+	}
+	gates := append([]string(nil), s.gates...)
+
+	// Process gates (simplified)
+	for len(gates) > 0 {
+		processed := false
+		for i, gate := range gates {
+			parts := strings.Split(gate, " -> ")
+			if len(parts) != 2 {
+				continue
+			}
+
+			expr := strings.Fields(parts[0])
+			output := parts[1]
+
+			if len(expr) == 3 {
+				wire1, op, wire2 := expr[0], expr[1], expr[2]
+				val1, ok1 := wireValues[wire1]
+				val2, ok2 := wireValues[wire2]
+
+				if ok1 && ok2 {
+					var result int
+					switch op {
+					case "AND":
+						result = val1 & val2
+					case "OR":
+						result = val1 | val2
+					case "XOR":
+						result = val1 ^ val2
+					}
+					wireValues[output] = result
+					gates = append(gates[:i], gates[i+1:]...)
+					processed = true
+					break
+				}
+			}
+		}
+		if !processed {
+			break
+		}
+	}
+
+	// Get z wires
+	var zWires []string
+	for wire := range wireValues {
+		if strings.HasPrefix(wire, "z") {
+			zWires = append(zWires, wire)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(zWires)))
+
+	binaryResult := ""
+	for _, wire := range zWires {
+		binaryResult += strconv.Itoa(wireValues[wire])
+	}
+
+	result, err := strconv.ParseInt(binaryResult, 2, 64)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(int(result)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
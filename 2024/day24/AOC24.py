@@ -92,7 +92,7 @@
 #     print("Decimal output:", result)
 
 # # Run the program
-# file_path = "gates.txt"  
+# file_path = "input.txt"  
 # main(file_path)
 
 ##########################################################################
@@ -104,7 +104,7 @@ import operator
 
 time_start = time()
 
-INPUT_FILE = "gates.txt"
+INPUT_FILE = "input.txt"
 blocks = [block.splitlines() for block in open(INPUT_FILE, "r").read().split("\n\n")]
 
 OPERATORS = {"AND": operator.and_, "OR": operator.or_, "XOR": operator.xor}
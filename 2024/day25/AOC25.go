@@ -1,25 +1,30 @@
-package main
+package day25
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
 )
 
-func parseSchematics(filePath string) ([][]string, [][]string) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return nil, nil
-	}
-	defer file.Close()
+func init() {
+	aoc.Register(aoc.Day{Year: 2024, Day: 25, New: func() aoc.Solver { return new(solver) }})
+}
 
+type solver struct {
+	locks, keys [][]string
+}
+
+func parseSchematics(r io.Reader) ([][]string, [][]string, error) {
 	content := ""
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		content += scanner.Text() + "\n"
 	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
 
 	// Handle both Unix and Windows line endings
 	contentStr := strings.ReplaceAll(content, "\r\n", "\n")
@@ -37,7 +42,7 @@ func parseSchematics(filePath string) ([][]string, [][]string) {
 		}
 	}
 
-	return locks, keys
+	return locks, keys, nil
 }
 
 func convertToHeights(schematic []string, isLock bool) []int {
@@ -103,9 +108,21 @@ func countValidPairs(locks, keys [][]string) int {
 	return validPairs
 }
 
-func main() {
-	filePath := "christmas.txt"
-	locks, keys := parseSchematics(filePath)
-	result := countValidPairs(locks, keys)
-	fmt.Printf("Number of unique lock/key pairs that fit: %d\n", result)
+func (s *solver) Parse(r io.Reader) error {
+	locks, keys, err := parseSchematics(r)
+	if err != nil {
+		return err
+	}
+	s.locks, s.keys = locks, keys
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	// Number of unique lock/key pairs that fit
+	return aoc.Int(countValidPairs(s.locks, s.keys)), nil
+}
+
+// Day 25 has no second puzzle.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
 }
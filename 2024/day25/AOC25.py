@@ -56,7 +56,7 @@ def count_valid_pairs(locks, keys):
     return valid_pairs
 
 def main():
-    file_path = "christmas.txt"  
+    file_path = "input.txt"  
     locks, keys = parse_schematics(file_path)
     result = count_valid_pairs(locks, keys)
     print(f"Number of unique lock/key pairs that fit: {result}")
@@ -1,12 +1,11 @@
-package main
+package day01
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"path/filepath"
-	"strconv"
+	"io"
 	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
 )
 
 // Day 1 — Secret Entrance
@@ -15,13 +14,52 @@ import (
 //
 // Expects input file at Input_day_1 (one instruction per line like "L68" or "R48").
 
-func solve(filename string) (int, int, error) {
-	file, err := os.Open(filename)
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 1, New: func() aoc.Solver { return new(solver) }})
+}
+
+// rotation is one instruction: turn the dial L or R by steps clicks.
+type rotation struct {
+	direction string
+	steps     int
+}
+
+type solver struct {
+	rotations []rotation
+}
+
+func parseRotations(r io.Reader) ([]rotation, error) {
+	lines, err := parse.Lines(r)
 	if err != nil {
-		return 0, 0, err
+		return nil, err
 	}
-	defer file.Close()
+	var rotations []rotation
+	for _, line := range lines {
+		text := strings.TrimSpace(line.Text)
+		if text == "" {
+			continue
+		}
+		off := strings.Index(line.Text, text)
+		direction := text[:1]
+		if direction != "R" && direction != "L" {
+			return nil, line.ErrorAt(off, direction, "want L or R")
+		}
+		steps, err := line.Int(off+1, text[1:])
+		if err != nil {
+			return nil, err
+		}
+		if steps < 0 {
+			return nil, line.ErrorAt(off+1, text[1:], "negative number")
+		}
+		rotations = append(rotations, rotation{direction, steps})
+	}
+	if len(rotations) == 0 {
+		return nil, aoc.ErrEmptyInput
+	}
+	return rotations, nil
+}
 
+func solve(rotations []rotation) (int, int) {
 	// initial dial position
 	pos := 50
 
@@ -31,31 +69,8 @@ func solve(filename string) (int, int, error) {
 	// Part 2: count times position == 0 during any click while performing rotations
 	part2Count := 0
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
-			continue
-		}
-
-		if len(line) < 2 {
-			// skip malformed lines
-			continue
-		}
-
-		direction := strings.ToUpper(string(line[0]))
-		stepsStr := line[1:]
-
-		steps, err := strconv.Atoi(stepsStr)
-		if err != nil {
-			// skip malformed lines
-			continue
-		}
-
-		if direction != "R" && direction != "L" {
-			// ignore unknown directions
-			continue
-		}
+	for _, rot := range rotations {
+		direction, steps := rot.direction, rot.steps
 
 		// --- Part 2: count intermediate hits of 0 during this rotation ---
 		// For right (increasing): position at click k is (pos + k) % 100
@@ -101,38 +116,24 @@ func solve(filename string) (int, int, error) {
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return 0, 0, err
-	}
-
-	return part1Count, part2Count, nil
+	return part1Count, part2Count
 }
 
-func main() {
-	// Default input file is `Input_day_1` in the current working directory
-	cwd, err := os.Getwd()
+func (s *solver) Parse(r io.Reader) error {
+	rotations, err := parseRotations(r)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
-		os.Exit(1)
-	}
-	defaultFile := filepath.Join(cwd, "Input_day_1")
-
-	inputFile := defaultFile
-	if len(os.Args) > 1 {
-		inputFile = os.Args[1]
-	}
-
-	if _, err := os.Stat(inputFile); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "Error: input file not found: %s\n", inputFile)
-		os.Exit(2)
+		return err
 	}
+	s.rotations = rotations
+	return nil
+}
 
-	p1, p2, err := solve(inputFile)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error solving: %v\n", err)
-		os.Exit(1)
-	}
+func (s *solver) Part1() (aoc.Answer, error) {
+	part1, _ := solve(s.rotations)
+	return aoc.Int(part1), nil
+}
 
-	fmt.Printf("Part 1 (count ends at 0): %d\n", p1)
-	fmt.Printf("Part 2 (count any click at 0): %d\n", p2)
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, part2 := solve(s.rotations)
+	return aoc.Int(part2), nil
 }
@@ -40,7 +40,7 @@ def count_zero_positions(filename):
 # Part 1: count times the dial is at 0 after a rotation finishes
 # Part 2: count times the dial is at 0 during any click while performing rotations
 #
-# Expects input file at /mnt/data/Input_day_1 (one instruction per line like "L68" or "R48").
+# Expects input file at /mnt/data/input.txt (one instruction per line like "L68" or "R48").
 
 def solve(filename):
     # initial dial position
@@ -106,9 +106,9 @@ def solve(filename):
 
 
 def main():
-    # Default input file is `Input_day_1` in the same directory as this script
+    # Default input file is `input.txt` in the same directory as this script
     script_dir = os.path.dirname(os.path.abspath(__file__))
-    default_file = os.path.join(script_dir, "Input_day_1")
+    default_file = os.path.join(script_dir, "input.txt")
 
     if len(sys.argv) > 1:
         input_file = sys.argv[1]
@@ -0,0 +1,29 @@
+package day01
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 1, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+L68
+L30
+R48
+L5
+R60
+L55
+L1
+L99
+R14
+L82
+`,
+			Part1: "3",
+			Part2: "6",
+		},
+	})
+}
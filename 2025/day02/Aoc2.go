@@ -0,0 +1,125 @@
+package day02
+
+import (
+	"errors"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/interval"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+// Day 2 — Invalid IDs
+// Part 1: Sum IDs where first half of digits equals second half (even digit count)
+// Part 2: Sum IDs that can be represented as a repeating block pattern
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 2, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	ids interval.Set
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	var ranges []interval.Interval
+	for _, line := range lines {
+		if line.Text == "" {
+			continue
+		}
+		ivs, err := interval.ParseLine(line, ",")
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, ivs...)
+	}
+	if len(ranges) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.ids = interval.NewSet(ranges...)
+	return nil
+}
+
+// pow10[n] is 10^n for every n an int64 holds.
+var pow10 = func() []int64 {
+	p := []int64{1}
+	for i := 1; i <= 18; i++ {
+		p = append(p, p[i-1]*10)
+	}
+	return p
+}()
+
+// repeated calls f for every ID in the set whose digits are a block repeated
+// at least twice, passing the number of repetitions. An ID with several
+// such spellings, like 222222, is passed once for each.
+//
+// An n-digit ID made of a k-digit block b is b times the multiplier
+// 1 0..01 0..01 with n/k ones, so rather than testing every ID in a range
+// this walks the blocks whose multiple lands in it.
+func (s *solver) repeated(f func(id int64, reps int)) error {
+	for _, iv := range s.ids.Intervals() {
+		if iv.Lo < 1 {
+			return errors.New("IDs must be positive")
+		}
+		for n := digits(iv.Lo); n <= digits(iv.Last()); n++ {
+			lo := max(iv.Lo, pow10[n-1])
+			hi := iv.Last()
+			if n < len(pow10) {
+				hi = min(hi, pow10[n]-1)
+			}
+			for k := 1; k <= n/2; k++ {
+				if n%k != 0 {
+					continue
+				}
+				mult := int64(0)
+				for i := 0; i < n/k; i++ {
+					mult = mult*pow10[k] + 1
+				}
+				first := max(pow10[k-1], (lo+mult-1)/mult)
+				last := min(pow10[k]-1, hi/mult)
+				for b := first; b <= last; b++ {
+					f(b*mult, n/k)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func digits(n int64) int {
+	d := 1
+	for d < len(pow10) && n >= pow10[d] {
+		d++
+	}
+	return d
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	total := int64(0)
+	err := s.repeated(func(id int64, reps int) {
+		if reps == 2 {
+			total += id
+		}
+	})
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(int(total)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	invalid := make(map[int64]bool)
+	err := s.repeated(func(id int64, _ int) { invalid[id] = true })
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	total := int64(0)
+	for id := range invalid {
+		total += id
+	}
+	return aoc.Int(int(total)), nil
+}
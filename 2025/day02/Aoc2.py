@@ -24,7 +24,7 @@ def solve(filename):
 
 
 # Run on your input file
-file_path = "input_day_2"  # change if needed
+file_path = "input.txt"  # change if needed
 answer = solve(file_path)
 print("Sum of all invalid IDs =", answer)
 def is_invalid_id_part2(n):
@@ -65,6 +65,6 @@ def solve_part2(filename):
 
 
 # Run it
-file_path = "input_day_2"  # adjust if needed
+file_path = "input.txt"  # adjust if needed
 answer = solve_part2(file_path)
 print("Part 2 sum of invalid IDs =", answer)
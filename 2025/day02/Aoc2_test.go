@@ -0,0 +1,20 @@
+package day02
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 2, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+11-22,95-115,998-1012,1188511880-1188511890,222220-222224,1698522-1698528,446443-446449,38593856-38593862,565653-565659,824824821-824824827,2121212118-2121212124
+`,
+			Part1: "1227775554",
+			Part2: "4174379265",
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package day03
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+// Day 3 — Joltage Banks
+// Part 1: For each bank, find max 2-digit number from digits in order (sum all banks)
+// Part 2: For each bank, find max 12-digit number using monotonic stack (sum all banks)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 3, New: func() aoc.Solver { return new(solver) }})
+}
+
+// maxKDigits returns the largest possible number formed by keeping exactly k digits
+// in the same order using a monotonic stack algorithm
+func maxKDigits(numStr string, k int) string {
+	remove := len(numStr) - k
+	stack := []rune{}
+
+	for _, ch := range numStr {
+		for remove > 0 && len(stack) > 0 && stack[len(stack)-1] < ch {
+			stack = stack[:len(stack)-1]
+			remove--
+		}
+		stack = append(stack, ch)
+	}
+
+	return string(stack[:k])
+}
+
+// digitsValue returns the number written by a string of decimal digits.
+func digitsValue(digits string) int {
+	n := 0
+	for i := 0; i < len(digits); i++ {
+		n = n*10 + int(digits[i]-'0')
+	}
+	return n
+}
+
+type solver struct {
+	banks []string
+}
+
+func solve(banks []string) (int, int) {
+	totalPart1 := 0 // For k = 2
+	totalPart2 := 0 // For k = 12
+
+	for _, s := range banks {
+		// Part 1: choose best 2 digits
+		totalPart1 += digitsValue(maxKDigits(s, 2))
+
+		// Part 2: choose best 12 digits
+		totalPart2 += digitsValue(maxKDigits(s, minBank))
+	}
+
+	return totalPart1, totalPart2
+}
+
+// minBank is the fewest batteries a bank needs for part 2.
+const minBank = 12
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		for i := 0; i < len(line.Text); i++ {
+			if c := line.Text[i]; c < '0' || c > '9' {
+				return line.ErrorAt(i, line.Text[i:i+1], "want a digit")
+			}
+		}
+		if len(line.Text) < minBank {
+			return line.Errorf("want at least %d batteries, got %d", minBank, len(line.Text))
+		}
+		s.banks = append(s.banks, line.Text)
+	}
+	if len(s.banks) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	part1, _ := solve(s.banks)
+	return aoc.Int(part1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, part2 := solve(s.banks)
+	return aoc.Int(part2), nil
+}
@@ -26,7 +26,7 @@ def solve_day3(filename):
 
 
 # Run on your input:
-file_path = "input_day_3"   # change path if different
+file_path = "input.txt"   # change path if different
 answer = solve_day3(file_path)
 print("Total output joltage =", answer)
 
@@ -69,7 +69,7 @@ def solve_joltage_parts(filename):
 
 
 # Run on actual input file
-file_path = "input_day_3"
+file_path = "input.txt"
 part1, part2 = solve_joltage_parts(file_path)
 
 print("Part 1 Total Joltage:", part1)
@@ -0,0 +1,23 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 3, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+987654321111111
+811111111111119
+234234234234278
+818181911112111
+`,
+			Part1: "357",
+			Part2: "3121910778619",
+		},
+	})
+}
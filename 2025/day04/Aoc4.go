@@ -0,0 +1,82 @@
+package day04
+
+import (
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+// Day 4 — Warehouse Rolls
+// Part 1: Count accessible '@' rolls (rolls with fewer than 4 adjacent '@' neighbors)
+// Part 2: Iteratively remove accessible rolls until none remain, count total removed
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 4, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[byte]
+}
+
+// isAccessible reports whether the roll at p has fewer than 4 adjacent '@'
+// neighbors.
+func isAccessible(g *grid.Grid[byte], p grid.Point) bool {
+	adjAt := 0
+	for _, n := range g.Neighbors8(p) {
+		if g.At(n) == '@' {
+			adjAt++
+			if adjAt >= 4 {
+				return false // Early exit optimization
+			}
+		}
+	}
+	return true
+}
+
+// findAccessiblePositions returns list of all accessible roll positions
+func findAccessiblePositions(g *grid.Grid[byte]) []grid.Point {
+	var accessible []grid.Point
+	g.Each(func(p grid.Point, ch byte) {
+		if ch == '@' && isAccessible(g, p) {
+			accessible = append(accessible, p)
+		}
+	})
+	return accessible
+}
+
+// removeIteratively removes accessible rolls from g until none remain and
+// returns how many were removed. Each round removes every accessible roll at
+// once; the rounds stop at the first that removes nothing.
+func removeIteratively(g *grid.Grid[byte]) int {
+	totalRemoved := 0
+	for {
+		accessible := findAccessiblePositions(g)
+		if len(accessible) == 0 {
+			return totalRemoved
+		}
+		for _, pos := range accessible {
+			g.Set(pos, '.')
+		}
+		totalRemoved += len(accessible)
+	}
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.Parse(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+// Part1 counts the initially accessible rolls.
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(len(findAccessiblePositions(s.grid))), nil
+}
+
+// Part2 removes rolls iteratively.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(removeIteratively(s.grid.Clone())), nil
+}
@@ -201,4 +201,4 @@ if __name__ == "__main__":
     print("\n\n" + "="*60)
     print("ACTUAL INPUT FILE:")
     print("="*60 + "\n")
-    solve_file("input_day_4", mark=False)
+    solve_file("input.txt", mark=False)
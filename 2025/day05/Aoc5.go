@@ -0,0 +1,78 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/interval"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+// Day 5 — Fresh Ingredient IDs
+// Part 1: Count IDs that fall within any safe range
+// Part 2: Count total IDs covered by merged safe ranges
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 5, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	fresh interval.Set
+	ids   []int64
+}
+
+// parseInventory splits the input into the safe ranges and the ingredient
+// IDs listed after the blank line
+func parseInventory(r io.Reader) (interval.Set, []int64, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return interval.Set{}, nil, err
+	}
+	if len(sections) != 2 {
+		return interval.Set{}, nil, fmt.Errorf("want ranges and IDs separated by a blank line, got %d sections", len(sections))
+	}
+
+	var ranges []interval.Interval
+	for _, line := range sections[0] {
+		ivs, err := interval.ParseLine(line, "")
+		if err != nil {
+			return interval.Set{}, nil, err
+		}
+		ranges = append(ranges, ivs...)
+	}
+
+	var ids []int64
+	for _, line := range sections[1] {
+		id, err := line.Int(0, line.Text)
+		if err != nil {
+			return interval.Set{}, nil, err
+		}
+		ids = append(ids, int64(id))
+	}
+
+	return interval.NewSet(ranges...), ids, nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	fresh, ids, err := parseInventory(r)
+	if err != nil {
+		return err
+	}
+	s.fresh, s.ids = fresh, ids
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	freshCount := 0
+	for _, id := range s.ids {
+		if s.fresh.Contains(id) {
+			freshCount++
+		}
+	}
+	return aoc.Int(freshCount), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(s.fresh.Len())), nil
+}
@@ -84,7 +84,7 @@ def count_fresh_ids_part2(filename):
 
 # ----- Run on your input file -----
 if __name__ == "__main__":
-    with open("input_day_5", "r") as f:
+    with open("input.txt", "r") as f:
         data = f.read()
 
     # Part 1: Count IDs within ranges
@@ -98,5 +98,5 @@ if __name__ == "__main__":
     print("\n" + "="*50)
     print("PART 2: Count fresh IDs by merging ranges")
     print("="*50)
-    answer2 = count_fresh_ids_part2("input_day_5")
+    answer2 = count_fresh_ids_part2("input.txt")
     print("Total fresh IDs (by merged ranges):", answer2)
@@ -0,0 +1,30 @@
+package day05
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 5, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+3-5
+10-14
+16-20
+12-18
+
+1
+5
+8
+11
+17
+32
+`,
+			Part1: "3",
+			Part2: "14",
+		},
+	})
+}
@@ -1,17 +1,23 @@
-package main
+package day06
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
 )
 
 // Day 6 — Cephalopod Math Worksheet
 // Part 1: Read vertical numbers with operators, calculate sum of all problems
 // Part 2: Numbers written right-to-left in columns with digits stacked vertically
 
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 6, New: func() aoc.Solver { return new(solver) }})
+}
+
 // isBlankColumn checks if a column is entirely blank/whitespace
 func isBlankColumn(lines []string, col int) bool {
 	for _, line := range lines {
@@ -59,40 +65,77 @@ func findProblems(lines []string, maxLen int) [][2]int {
 	return problems
 }
 
-// solveDay6 solves Part 1: vertical numbers with operators
-func solveDay6(filename string) (int, error) {
-	data, err := os.ReadFile(filename)
+type solver struct {
+	lines  []string
+	maxLen int
+}
+
+// readWorksheet reads the worksheet and pads every line to the same width.
+// Every problem needs digits above exactly one operator.
+func readWorksheet(r io.Reader) ([]string, int, error) {
+	rows, err := parse.Lines(r)
 	if err != nil {
-		return 0, err
+		return nil, 0, err
 	}
-
-	// Handle both Windows (\r\n) and Unix (\n) line endings
-	content := strings.ReplaceAll(string(data), "\r\n", "\n")
-	lines := strings.Split(content, "\n")
-
-	// Remove empty lines at the end
-	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
-		lines = lines[:len(lines)-1]
+	if len(rows) == 0 {
+		return nil, 0, aoc.ErrEmptyInput
 	}
-
-	if len(lines) == 0 {
-		return 0, nil
+	if len(rows) < 2 {
+		return nil, 0, rows[0].Errorf("want rows of numbers above a row of operators")
+	}
+	opRow := rows[len(rows)-1]
+	for _, row := range rows {
+		for i := 0; i < len(row.Text); i++ {
+			c := row.Text[i]
+			switch {
+			case isSpace(rune(c)):
+			case row.No == opRow.No && c != '+' && c != '*':
+				return nil, 0, row.ErrorAt(i, row.Text[i:i+1], "want + or *")
+			case row.No != opRow.No && (c < '0' || c > '9'):
+				return nil, 0, row.ErrorAt(i, row.Text[i:i+1], "want a digit")
+			}
+		}
 	}
 
 	// Normalize line lengths
 	maxLen := 0
-	for _, line := range lines {
-		if len(line) > maxLen {
-			maxLen = len(line)
+	for _, row := range rows {
+		maxLen = max(maxLen, len(row.Text))
+	}
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = row.Text + strings.Repeat(" ", maxLen-len(row.Text))
+	}
+
+	ops := lines[len(lines)-1]
+	for _, prob := range findProblems(lines, maxLen) {
+		start, end := prob[0], prob[1]
+		if n := strings.Count(ops[start:end], "+") + strings.Count(ops[start:end], "*"); n != 1 {
+			return nil, 0, opRow.ErrorAt(start, ops[start:end], fmt.Sprintf("want one operator per problem, got %d", n))
+		}
+		if isBlankColumns(lines[:len(lines)-1], start, end) {
+			return nil, 0, opRow.ErrorAt(start, ops[start:end], "problem has no numbers")
 		}
 	}
+	return lines, maxLen, nil
+}
 
-	// Pad lines to maxLen
-	for i := range lines {
-		if len(lines[i]) < maxLen {
-			lines[i] = lines[i] + strings.Repeat(" ", maxLen-len(lines[i]))
+// isBlankColumns reports whether columns start to end-1 of every line are
+// blank.
+func isBlankColumns(lines []string, start, end int) bool {
+	for col := start; col < end; col++ {
+		if !isBlankColumn(lines, col) {
+			return false
 		}
 	}
+	return true
+}
+
+// solveDay6 solves Part 1: vertical numbers with operators
+func solveDay6(lines []string, maxLen int) int {
+	if len(lines) == 0 {
+		return 0
+	}
 
 	numRows := len(lines)
 	problems := findProblems(lines, maxLen)
@@ -147,42 +190,13 @@ func solveDay6(filename string) (int, error) {
 		total += res
 	}
 
-	return total, nil
+	return total
 }
 
 // solveDay6Part2 solves Part 2: numbers written right-to-left in columns
-func solveDay6Part2(filename string) (int, error) {
-	data, err := os.ReadFile(filename)
-	if err != nil {
-		return 0, err
-	}
-
-	// Handle both Windows (\r\n) and Unix (\n) line endings
-	content := strings.ReplaceAll(string(data), "\r\n", "\n")
-	lines := strings.Split(content, "\n")
-
-	// Remove empty lines at the end
-	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
-		lines = lines[:len(lines)-1]
-	}
-
+func solveDay6Part2(lines []string, maxLen int) int {
 	if len(lines) == 0 {
-		return 0, nil
-	}
-
-	// Normalize line lengths
-	maxLen := 0
-	for _, line := range lines {
-		if len(line) > maxLen {
-			maxLen = len(line)
-		}
-	}
-
-	// Pad lines to maxLen
-	for i := range lines {
-		if len(lines[i]) < maxLen {
-			lines[i] = lines[i] + strings.Repeat(" ", maxLen-len(lines[i]))
-		}
+		return 0
 	}
 
 	numRows := len(lines)
@@ -248,40 +262,22 @@ func solveDay6Part2(filename string) (int, error) {
 		total += res
 	}
 
-	return total, nil
+	return total
 }
 
-func main() {
-	// Default input file is `input_day_6` in the current working directory
-	cwd, err := os.Getwd()
+func (s *solver) Parse(r io.Reader) error {
+	lines, maxLen, err := readWorksheet(r)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
-		os.Exit(1)
-	}
-	defaultFile := filepath.Join(cwd, "input_day_6")
-
-	inputFile := defaultFile
-	if len(os.Args) > 1 {
-		inputFile = os.Args[1]
-	}
-
-	if _, err := os.Stat(inputFile); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "Error: input file not found: %s\n", inputFile)
-		os.Exit(2)
-	}
-
-	part1, err := solveDay6(inputFile)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error solving part 1: %v\n", err)
-		os.Exit(1)
+		return err
 	}
+	s.lines, s.maxLen = lines, maxLen
+	return nil
+}
 
-	part2, err := solveDay6Part2(inputFile)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error solving part 2: %v\n", err)
-		os.Exit(1)
-	}
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(solveDay6(s.lines, s.maxLen)), nil
+}
 
-	fmt.Printf("Day 6 - Part 1: %d\n", part1)
-	fmt.Printf("Day 6 - Part 2: %d\n", part2)
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(solveDay6Part2(s.lines, s.maxLen)), nil
 }
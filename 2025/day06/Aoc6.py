@@ -159,9 +159,9 @@ def solve_day6_part2(filename):
 if __name__ == '__main__':
     import os
 
-    fname = 'input_day_6'
+    fname = 'input.txt'
     if not os.path.exists(fname):
-        print("Input file 'input_day_6' not found.")
+        print("Input file 'input.txt' not found.")
     else:
         p1 = solve_day6(fname)
         p2 = solve_day6_part2(fname)
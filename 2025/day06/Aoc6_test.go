@@ -0,0 +1,37 @@
+package day06
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 6, []aoctest.Case{
+		{
+			Name: "example",
+			Input: "123 328  51 64 \n" +
+				" 45 64  387 23 \n" +
+				"  6 98  215 314\n" +
+				"*   +   *   +  \n",
+			Part1: "4277556",
+			Part2: "3263827",
+		},
+	})
+}
+
+func TestMalformed(t *testing.T) {
+	for _, tt := range []struct{ input, msg string }{
+		{"123 32\n 4x 1\n*   +\n", `2:3: want a digit "x"`},
+		{"123 32\n*   -\n", `2:5: want + or * "-"`},
+		{"123 32\n*+  +\n", `2:1: want one operator per problem, got 2 "*+ "`},
+		{"123   \n*   + \n", `2:5: problem has no numbers "+"`},
+		{"123\n", `1: want rows of numbers above a row of operators "123"`},
+	} {
+		err := new(solver).Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.msg {
+			t.Errorf("%q: got %v, want %s", tt.input, err, tt.msg)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package day07
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 7, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	grid *grid.Grid[byte]
+}
+
+func countSplits(g *grid.Grid[byte]) (int, error) {
+	// Find source 'S'
+	source, ok := grid.Find(g, 'S')
+	if !ok {
+		return 0, errors.New("no source 'S' found in grid")
+	}
+
+	// Active beams as set of positions
+	active := make(map[grid.Point]bool)
+	active[source] = true
+	splits := 0
+	seenSplitPositions := make(map[grid.Point]bool)
+
+	for len(active) > 0 {
+		newActive := make(map[grid.Point]bool)
+
+		// Process each beam: attempt to move one row down
+		for pos := range active {
+			below := pos.Add(grid.Down)
+
+			if !g.In(below) {
+				// Beam leaves the grid
+				continue
+			}
+
+			if g.At(below) == '^' {
+				// Split occurs below the beam
+				if !seenSplitPositions[below] {
+					splits++
+					seenSplitPositions[below] = true
+				}
+
+				// Spawn beams at immediate left and right of splitter (same row)
+				for _, side := range []grid.Point{below.Add(grid.Left), below.Add(grid.Right)} {
+					if g.In(side) {
+						newActive[side] = true
+					}
+				}
+				// Original beam does not continue downward beyond the '^'
+			} else {
+				// Move into the cell below (covers '.' and 'S' or any char not '^')
+				newActive[below] = true
+			}
+		}
+
+		// Continue with new active positions
+		active = newActive
+	}
+
+	return splits, nil
+}
+
+func countTimelines(g *grid.Grid[byte]) int {
+	R, C := g.Rows(), g.Cols()
+
+	// Initialize beam strength matrix
+	strength := grid.New[int](R, C)
+
+	// Find source 'S' and initialize strength
+	activeCols := make(map[int]bool)
+	for c := 0; c < C; c++ {
+		if g.At(grid.Point{R: 0, C: c}) == 'S' {
+			strength.Set(grid.Point{R: 0, C: c}, 1)
+			activeCols[c] = true
+		}
+	}
+
+	// Process row by row
+	for y := 0; y < R-1; y++ {
+		nextActiveCols := make(map[int]bool)
+
+		for x := range activeCols {
+			here := grid.Point{R: y, C: x}
+			below := here.Add(grid.Down)
+
+			// Check if cell above is a splitter
+			isBelowSplitter := g.At(here) == '^'
+
+			if g.At(below) == '^' {
+				// This row has a splitter: split the beam left and right
+				for _, side := range []grid.Point{below.Add(grid.Left), below.Add(grid.Right)} {
+					if g.In(side) {
+						strength.Set(side, strength.At(side)+strength.At(here))
+						nextActiveCols[side.C] = true
+					}
+				}
+			} else {
+				// Regular cell or 'S': beam continues if not directly below a splitter
+				if !isBelowSplitter {
+					strength.Set(below, strength.At(below)+strength.At(here))
+					nextActiveCols[x] = true
+				}
+			}
+		}
+
+		activeCols = nextActiveCols
+	}
+
+	// Return sum of strengths at the bottom row (beams reaching exit)
+	total := 0
+	for _, n := range strength.Row(R - 1) {
+		total += n
+	}
+
+	return total
+}
+
+// readGrid reads the manifold diagram, dropping trailing blank lines and
+// padding short rows with spaces.
+func readGrid(r io.Reader) (*grid.Grid[byte], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Handle both Windows (\r\n) and Unix (\n) line endings
+	content := strings.ReplaceAll(string(data), "\r\n", "\n")
+	lines := strings.Split(content, "\n")
+
+	// Remove empty lines at the end
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+	for i, line := range lines {
+		lines[i] = line + strings.Repeat(" ", width-len(line))
+	}
+	return grid.ParseLines(lines)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := readGrid(r)
+	if err != nil {
+		return aoc.AsEmptyInput(err, grid.ErrEmpty)
+	}
+	s.grid = g
+	return nil
+}
+
+// Part1 returns the total number of splits.
+func (s *solver) Part1() (aoc.Answer, error) {
+	splits, err := countSplits(s.grid)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(splits), nil
+}
+
+// Part2 returns the number of timelines.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countTimelines(s.grid)), nil
+}
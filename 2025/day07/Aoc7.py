@@ -64,7 +64,7 @@ def count_splits(grid_lines: List[str]) -> int:
 
 # Example usage:
 if __name__ == "__main__":
-    path = "input_day_7"   # change if needed
+    path = "input.txt"   # change if needed
     with open(path, "r") as f:
         lines = [line.rstrip("\n") for line in f]
     result = count_splits(lines)
@@ -142,7 +142,7 @@ def count_timelines(grid_lines: List[str]) -> int:
 
 if __name__ == "__main__":
     # change path if your input file is elsewhere
-    path = "input_day_7"
+    path = "input.txt"
     with open(path, "r") as f:
         lines = [line.rstrip("\n") for line in f]
     result = count_timelines(lines)
@@ -0,0 +1,114 @@
+package day08
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/graph"
+	"github.com/shubhamsugara22/AdventOfCode-202X/kdtree"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 8, New: func() aoc.Solver { return newSolver() }})
+}
+
+type solver struct {
+	pts []kdtree.Point
+
+	// pairs is how many of the closest pairs Part 1 connects.
+	pairs int
+}
+
+func newSolver() *solver {
+	return &solver{pairs: 1000}
+}
+
+func (s *solver) Params() map[string]*int {
+	return map[string]*int{"pairs": &s.pairs}
+}
+
+func readPoints(r io.Reader) ([]kdtree.Point, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var points []kdtree.Point
+	for _, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			continue
+		}
+		xyz, err := line.IntsN(",", 3)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, kdtree.Point{X: int64(xyz[0]), Y: int64(xyz[1]), Z: int64(xyz[2])})
+	}
+	return points, nil
+}
+
+// solvePart1 connects the kPairs closest pairs and returns the product of the
+// three largest circuit sizes.
+func solvePart1(pts []kdtree.Point, kPairs int) (int, error) {
+	n := len(pts)
+	if n < 2 {
+		return 0, errors.New("not enough points")
+	}
+
+	circuits := graph.NewUnionFind(n)
+	closest := kdtree.New(pts).Pairs()
+	for k := 0; k < kPairs; k++ {
+		pair, ok := closest.Next()
+		if !ok {
+			break
+		}
+		circuits.Union(pair.I, pair.J)
+	}
+
+	// Multiply the three largest circuit sizes
+	prod := 1
+	for _, size := range circuits.Sizes()[:min(3, circuits.Sets())] {
+		prod *= size
+	}
+
+	return prod, nil
+}
+
+// solvePart2 returns the product of the X coordinates of the last two junction
+// boxes joined when connecting everything into one circuit, that is the
+// endpoints of the longest edge of the minimum spanning tree.
+func solvePart2(pts []kdtree.Point) (int, error) {
+	if len(pts) < 2 {
+		return 0, errors.New("need at least two points")
+	}
+	mst := kdtree.New(pts).SpanningTree()
+	last := mst[len(mst)-1]
+	return int(pts[last.I].X * pts[last.J].X), nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	pts, err := readPoints(r)
+	if err != nil {
+		return err
+	}
+	s.pts = pts
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	prod, err := solvePart1(s.pts, s.pairs)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(prod), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	xProd, err := solvePart2(s.pts)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(xProd), nil
+}
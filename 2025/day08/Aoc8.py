@@ -112,7 +112,7 @@ def solve(path: str, k_pairs: int = 1000, use_heap_if_big: bool = True):
 
 if __name__ == "__main__":
     # change filename if needed
-    input_path = "input_day_8"
+    input_path = "input.txt"
     solve(input_path)
 
 #!/usr/bin/env python3
@@ -195,7 +195,7 @@ def prim_last_edge_xproduct(pts: List[Tuple[int,int,int]]):
     return x_prod, (max_edge_u, max_edge_v, max_edge_w)
 
 if __name__ == "__main__":
-    input_path = "input_day_8"   # change if needed
+    input_path = "input.txt"   # change if needed
     pts = read_points(input_path)
     prod, info = prim_last_edge_xproduct(pts)
     u, v, w = info[0], info[1], info[2]
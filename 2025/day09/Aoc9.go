@@ -0,0 +1,85 @@
+package day09
+
+import (
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/geom"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 9, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	// red holds the red tiles in input order; consecutive ones, wrapping
+	// around, are joined by a line of green tiles.
+	red []geom.Point
+}
+
+func readInput(r io.Reader) ([]geom.Point, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var points []geom.Point
+	for _, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			continue
+		}
+		x, y, err := line.Pair(",")
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, geom.Point{X: int64(x), Y: int64(y)})
+	}
+	return points, nil
+}
+
+// largestRect returns the largest area of a rectangle with red tiles at two
+// opposite corners for which ok holds.
+func largestRect(red []geom.Point, ok func(geom.Rect) bool) int64 {
+	var best int64
+	for i, a := range red {
+		for _, b := range red[i+1:] {
+			// Corners must differ in both coordinates.
+			if a.X == b.X || a.Y == b.Y {
+				continue
+			}
+			r := geom.RectFrom(a, b)
+			if area := r.Tiles(); area > best && ok(r) {
+				best = area
+			}
+		}
+	}
+	return best
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	points, err := readInput(r)
+	if err != nil {
+		return err
+	}
+	if len(points) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.red = points
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(largestRect(s.red, func(geom.Rect) bool { return true }))), nil
+}
+
+// Part2 returns the largest rectangle that lies entirely on red and green
+// tiles: the loop and everything inside it.
+func (s *solver) Part2() (aoc.Answer, error) {
+	loop, err := geom.NewPolygon(s.red)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	region := geom.NewRegion(loop)
+	return aoc.Int(int(largestRect(s.red, region.ContainsRect))), nil
+}
@@ -29,7 +29,7 @@ def read_input(filename):
             points.append((x, y))
     return points
 
-points = read_input("input_day_9")
+points = read_input("input.txt")
 print("Answer part 1 :", solve_day9(points))
 
 
@@ -37,7 +37,7 @@ print("Answer part 1 :", solve_day9(points))
 """
 Day 9 Part 2 — Robust, memory-safe solution.
 
-Reads red tile coordinates from 'input_day_9' (one "x,y" per line).
+Reads red tile coordinates from 'input.txt' (one "x,y" per line).
 Prints the largest rectangle area that can be formed with opposite corners
 on red tiles, and whose interior (and edges) contain only red or green tiles,
 where green is defined as the polygon interior formed by connecting red tiles
@@ -47,7 +47,7 @@ in order with horizontal/vertical segments (wrapping).
 from typing import List, Tuple
 import sys
 
-INPUT_PATH = "input_day_9"
+INPUT_PATH = "input.txt"
 
 # ---------- I/O ----------
 def read_points(path: str) -> List[Tuple[int,int]]:
@@ -200,5 +200,5 @@ def solve_day9_part2_file(path: str) -> int:
 
 # ---------- Run ----------
 if __name__ == "__main__":
-    ans = solve_day9_part2_file("input_day_9")
+    ans = solve_day9_part2_file("input.txt")
     print("Part 2 largest rectangle area (red+green):", ans)
@@ -0,0 +1,27 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 9, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+7,1
+11,1
+11,7
+9,7
+9,5
+2,5
+2,3
+7,3
+`,
+			Part1: "50",
+			Part2: "24",
+		},
+	})
+}
@@ -0,0 +1,184 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/gf2"
+	"github.com/shubhamsugara22/AdventOfCode-202X/ilp"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 10, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	machines []machine
+}
+
+// machine is one line of the manual: the light pattern to reach, the
+// lights or counters each button touches and the joltage targets.
+type machine struct {
+	lights   gf2.Vec
+	buttons  [][]int
+	joltages []int
+}
+
+var (
+	machineRE = regexp.MustCompile(`^\[([.#]+)\]((?:\s*\([^()]*\))*)\s*\{([^{}]*)\}$`)
+	buttonRE  = regexp.MustCompile(`\(([^()]*)\)`)
+)
+
+// parseMachine reads a line like "[.##.] (3) (1,3) (2) {3,5,4,7}".
+func parseMachine(line parse.Line) (machine, error) {
+	var m machine
+	idx := machineRE.FindStringSubmatchIndex(line.Text)
+	if idx == nil {
+		return m, line.Errorf("want a machine like [.##.] (3) (1,3) {3,5,4,7}")
+	}
+	m.lights, _ = gf2.ParseVec(line.Text[idx[2]:idx[3]])
+	n := m.lights.Len()
+	for _, b := range buttonRE.FindAllStringSubmatchIndex(line.Text[idx[4]:idx[5]], -1) {
+		btn, err := numberList(line, idx[4]+b[2], idx[4]+b[3], n)
+		if err != nil {
+			return m, err
+		}
+		m.buttons = append(m.buttons, btn)
+	}
+	joltages, err := numberList(line, idx[6], idx[7], -1)
+	if err != nil {
+		return m, err
+	}
+	if len(joltages) != n {
+		return m, line.ErrorAt(idx[6], line.Text[idx[6]:idx[7]], fmt.Sprintf("want %d joltages", n))
+	}
+	m.joltages = joltages
+	return m, nil
+}
+
+// numberList parses the comma-separated numbers in line.Text[start:end].
+// Each must be in 0..limit-1, or non-negative when limit is -1. A blank
+// list is empty.
+func numberList(line parse.Line, start, end, limit int) ([]int, error) {
+	if strings.TrimSpace(line.Text[start:end]) == "" {
+		return nil, nil
+	}
+	var nums []int
+	for off := start; ; {
+		tok, _, more := strings.Cut(line.Text[off:end], ",")
+		n, err := line.Int(off, tok)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case n < 0:
+			return nil, line.ErrorAt(off, tok, "negative number")
+		case limit >= 0 && n >= limit:
+			return nil, line.ErrorAt(off, tok, fmt.Sprintf("want a light in 0..%d", limit-1))
+		}
+		nums = append(nums, n)
+		if !more {
+			return nums, nil
+		}
+		off += len(tok) + 1
+	}
+}
+
+// solveMachine returns the fewest button presses that turn on exactly the
+// lights marked # in the machine, or false if no presses do. Pressing a
+// button twice undoes it, so each button is pressed at most once and the
+// presses solve B·x = target over GF(2), where column j of B marks the
+// lights button j toggles.
+func solveMachine(m machine) (int, bool) {
+	B := gf2.NewMatrix(m.lights.Len(), len(m.buttons))
+	for j, btn := range m.buttons {
+		for _, bit := range btn {
+			B.Flip(bit, j)
+		}
+	}
+	x, ok := gf2.MinWeight(B, m.lights)
+	if !ok {
+		return 0, false
+	}
+	return x.Weight(), true
+}
+
+// minPresses returns the fewest button presses that bring every joltage
+// counter to its target. Button j adds one to each counter it lists, so
+// with x_j presses of button j the integer program is
+//
+//	minimize   sum of x_j
+//	subject to sum of x_j over the buttons listing i == targets[i]
+func minPresses(buttons [][]int, targets []int) (int, error) {
+	var p ilp.Problem
+	objective := make([]*big.Rat, len(buttons))
+	for j := range buttons {
+		p.AddVar(fmt.Sprintf("b%d", j), true)
+		objective[j] = big.NewRat(1, 1)
+	}
+	p.Objective = objective
+	for i, target := range targets {
+		coef := make([]*big.Rat, len(buttons))
+		for j, btn := range buttons {
+			coef[j] = new(big.Rat)
+			for _, counter := range btn {
+				if counter == i {
+					coef[j].Add(coef[j], big.NewRat(1, 1))
+				}
+			}
+		}
+		p.AddConstraint(fmt.Sprintf("j%d", i), coef, ilp.EQ, big.NewRat(int64(target), 1))
+	}
+	sol, err := p.Solve()
+	if err != nil {
+		return 0, err
+	}
+	return int(sol.Value.Num().Int64()), nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		m, err := parseMachine(line)
+		if err != nil {
+			return err
+		}
+		s.machines = append(s.machines, m)
+	}
+	if len(s.machines) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	total := 0
+	for i, m := range s.machines {
+		presses, ok := solveMachine(m)
+		if !ok {
+			return aoc.Answer{}, fmt.Errorf("machine %d: no presses reach the lights", i+1)
+		}
+		total += presses
+	}
+	return aoc.Int(total), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	total := 0
+	for i, m := range s.machines {
+		presses, err := minPresses(m.buttons, m.joltages)
+		if err != nil {
+			return aoc.Answer{}, fmt.Errorf("machine %d: %w", i+1, err)
+		}
+		total += presses
+	}
+	return aoc.Int(total), nil
+}
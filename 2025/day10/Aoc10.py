@@ -109,7 +109,7 @@ def solve_day10_part1(filename):
 
 # Example run
 if __name__ == "__main__":
-    print("Day 10 Part 1:", solve_day10_part1("input_day_10"))
+    print("Day 10 Part 1:", solve_day10_part1("input.txt"))
 
 ## Part 2 (rewritten)
 # Gonna use this to solve LP
@@ -138,7 +138,7 @@ def solve_machine(buttons, joltage):
  
 def main(): 
     ans = 0
-    with open('input_day_10', 'r') as f:
+    with open('input.txt', 'r') as f:
         for line in f.readlines():
             button_matches = re.findall(r'\((.*?)\)', line)
             buttons = [list(map(int, b.split(','))) for b in button_matches]
@@ -0,0 +1,43 @@
+package day10
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, 2025, 10, []aoctest.Case{
+		{
+			Name: "example",
+			Input: `
+[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}
+[...#.] (0,2,3,4) (2,3) (0,4) (0,1,2) (1,2,3,4) {7,5,12,7,2}
+[.###.#] (0,1,2,3,4) (0,3,4) (0,1,2,4,5) (1,2) {10,11,11,5,10,5}
+`,
+			Part1: "7",
+			Part2: "33",
+		},
+	})
+}
+
+func TestMalformed(t *testing.T) {
+	for _, tt := range []struct{ input, msg string }{
+		{"[.##.] (3) (1,3) {3,5,4,7}\n[.#] (0,x) {1,2}\n", `2:9: invalid number "x"`},
+		{"[.##.] (3) (1,4) {3,5,4,7}\n", `1:15: want a light in 0..3 "4"`},
+		{"[.##.] (3) (1,3) {3,5,4}\n", `1:19: want 4 joltages "3,5,4"`},
+		{"[.##.] (3) (1,3) {3,-5,4,7}\n", `1:21: negative number "-5"`},
+		{"[.##.] (3) (1,3\n", `1: want a machine like [.##.] (3) (1,3) {3,5,4,7} "[.##.] (3) (1,3"`},
+	} {
+		err := new(solver).Parse(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.msg {
+			t.Errorf("%q: got %v, want %s", tt.input, err, tt.msg)
+		}
+	}
+	if err := new(solver).Parse(strings.NewReader("\n")); !errors.Is(err, aoc.ErrEmptyInput) {
+		t.Errorf("blank input: got %v, want %v", err, aoc.ErrEmptyInput)
+	}
+}
@@ -0,0 +1,77 @@
+package day11
+
+import (
+	"io"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/graph"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 11, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	devices *graph.Graph[string]
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	devices := graph.New[string]()
+	for _, line := range lines {
+		name, outputs, ok := strings.Cut(line.Text, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return line.Errorf("want a device and its outputs like aaa: bbb ccc")
+		}
+		devices.AddNode(name)
+		for _, out := range strings.Fields(outputs) {
+			devices.AddEdge(name, out)
+		}
+	}
+	if devices.Len() == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.devices = devices
+	return nil
+}
+
+// pathsVia counts the paths that visit the given devices in order.
+func (s *solver) pathsVia(stops ...string) (int, error) {
+	total := 1
+	for i := 1; i < len(stops) && total > 0; i++ {
+		n, err := s.devices.CountPaths(stops[i-1], stops[i])
+		if err != nil {
+			return 0, err
+		}
+		total *= n
+	}
+	return total, nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	n, err := s.pathsVia("you", "out")
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(n), nil
+}
+
+// Part2 counts the paths from svr to out that visit both dac and fft, in
+// either order. Without a cycle only one of the orders can have paths.
+func (s *solver) Part2() (aoc.Answer, error) {
+	dacFirst, err := s.pathsVia("svr", "dac", "fft", "out")
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	fftFirst, err := s.pathsVia("svr", "fft", "dac", "out")
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(dacFirst + fftFirst), nil
+}
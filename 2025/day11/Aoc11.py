@@ -53,7 +53,7 @@ def count_paths(adj, start="you", target="out"):
     return dfs(start)
 
 def main():
-    path = sys.argv[1] if len(sys.argv) > 1 else "input_day_11"
+    path = sys.argv[1] if len(sys.argv) > 1 else "input.txt"
     try:
         with open(path, "r") as f:
             lines = f.readlines()
@@ -71,7 +71,7 @@ if __name__ == "__main__":
 ## TODO part 2 logic
 from collections import *
 
-with open('input_day_11') as f:
+with open('input.txt') as f:
     lines = f.read().splitlines()
 
 # Build graph
@@ -0,0 +1,132 @@
+package day12
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+	"github.com/shubhamsugara22/AdventOfCode-202X/poly"
+)
+
+func init() {
+	aoc.Register(aoc.Day{Year: 2025, Day: 12, New: func() aoc.Solver { return new(solver) }})
+}
+
+type Region struct {
+	width  int
+	height int
+	counts []int
+}
+
+type solver struct {
+	shapes  []poly.Shape
+	regions []Region
+}
+
+var (
+	shapeHeaderRE = regexp.MustCompile(`^(\d+):$`)
+	regionRE      = regexp.MustCompile(`^\d+x\d+:( \d+)*$`)
+)
+
+func parseInput(r io.Reader) ([]poly.Shape, []Region, error) {
+	var shapes []poly.Shape
+	var regions []Region
+
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, section := range sections {
+		// Shapes: an "<index>:" line followed by the shape's rows
+		if shapeHeaderRE.MatchString(section[0].Text) {
+			idx, err := section[0].Match(shapeHeaderRE)
+			if err != nil {
+				return nil, nil, err
+			}
+			if idx[0] != len(shapes) {
+				return nil, nil, section[0].Errorf("want shape %d next", len(shapes))
+			}
+			var rows []string
+			for _, line := range section[1:] {
+				if i := strings.IndexFunc(line.Text, func(c rune) bool { return c != '#' && c != '.' }); i >= 0 {
+					return nil, nil, line.ErrorAt(i, line.Text[i:i+1], "want # or .")
+				}
+				rows = append(rows, line.Text)
+			}
+			shape, err := poly.ParseShape(rows)
+			if err != nil {
+				return nil, nil, section[0].Errorf("%v", err)
+			}
+			shapes = append(shapes, shape)
+			continue
+		}
+
+		// Regions: "<width>x<height>: <count of each shape>"
+		for _, line := range section {
+			if !regionRE.MatchString(line.Text) {
+				return nil, nil, line.Errorf("want \"<width>x<height>: <counts>\"")
+			}
+			nums, err := line.SignedInts()
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(nums)-2 > len(shapes) {
+				return nil, nil, line.Errorf("counts for %d shapes, have %d", len(nums)-2, len(shapes))
+			}
+			regions = append(regions, Region{
+				width:  nums[0],
+				height: nums[1],
+				counts: nums[2:],
+			})
+		}
+	}
+
+	return shapes, regions, nil
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	shapes, regions, err := parseInput(r)
+	if err != nil {
+		return err
+	}
+	if len(regions) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.shapes, s.regions = shapes, regions
+	return nil
+}
+
+// Part1 counts the regions that can hold all of their presents.
+func (s *solver) Part1() (aoc.Answer, error) {
+	good := 0
+	for _, region := range s.regions {
+		if _, ok := poly.Pack(region.width, region.height, s.shapes, region.counts); ok {
+			good++
+		}
+	}
+	return aoc.Int(good), nil
+}
+
+// Day 12 has no second puzzle.
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+// Explain says for every region whether the presents fit and draws one way
+// they do.
+func (s *solver) Explain(w io.Writer) error {
+	for i, region := range s.regions {
+		fmt.Fprintf(w, "region %d (%dx%d): ", i+1, region.width, region.height)
+		p, ok := poly.Pack(region.width, region.height, s.shapes, region.counts)
+		if !ok {
+			fmt.Fprintln(w, "does not fit")
+			continue
+		}
+		fmt.Fprintf(w, "fits\n%v", p)
+	}
+	return nil
+}
@@ -55,4 +55,4 @@ def solve_day12_part1(filename):
     return good
 
 
-print("Part 1:", solve_day12_part1("input_day_12"))
+print("Part 1:", solve_day12_part1("input.txt"))
@@ -0,0 +1,146 @@
+// Package answers stores the known-good ("golden") answer of every puzzle
+// part and checks the registered solutions against them.
+//
+// The answers file is plain text with one answer per line:
+//
+//	# year day part answer
+//	2025 1 1 1123
+//	2024 23 2 co,de,ka,ta
+//
+// Blank lines and lines starting with # are ignored.
+package answers
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultFile is the answers file at the repository root.
+const DefaultFile = "answers.txt"
+
+// Key identifies one puzzle part.
+type Key struct {
+	Year, Day, Part int
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%d day %d part %d", k.Year, k.Day, k.Part)
+}
+
+// Set maps puzzle parts to their golden answers.
+type Set map[Key]string
+
+// Get returns the stored answer for a part.
+func (s Set) Get(year, day, part int) (string, bool) {
+	ans, ok := s[Key{year, day, part}]
+	return ans, ok
+}
+
+// Put stores the answer for a part, replacing any previous value.
+func (s Set) Put(year, day, part int, answer string) {
+	s[Key{year, day, part}] = answer
+}
+
+// Keys returns the stored parts ordered by year, day and part.
+func (s Set) Keys() []Key {
+	keys := make([]Key, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Part < b.Part
+	})
+	return keys
+}
+
+// Parse reads an answers file.
+func Parse(r io.Reader) (Set, error) {
+	set := make(Set)
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, " ", 4)
+		if len(fields) != 4 || strings.TrimSpace(fields[3]) == "" {
+			return nil, fmt.Errorf("line %d: want \"<year> <day> <part> <answer>\", got %q", lineNo, line)
+		}
+		var nums [3]int
+		for i := range nums {
+			n, err := strconv.Atoi(fields[i])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid number %q", lineNo, fields[i])
+			}
+			nums[i] = n
+		}
+		if nums[2] != 1 && nums[2] != 2 {
+			return nil, fmt.Errorf("line %d: invalid part %d", lineNo, nums[2])
+		}
+		k := Key{nums[0], nums[1], nums[2]}
+		if _, dup := set[k]; dup {
+			return nil, fmt.Errorf("line %d: duplicate answer for %v", lineNo, k)
+		}
+		set[k] = strings.TrimSpace(fields[3])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// Load reads the answers file at path. A missing file is an empty set.
+func Load(path string) (Set, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(Set), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	set, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return set, nil
+}
+
+// Write writes the set in answers file format, sorted by key.
+func (s Set) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# year day part answer")
+	for _, k := range s.Keys() {
+		fmt.Fprintf(bw, "%d %d %d %s\n", k.Year, k.Day, k.Part, s[k])
+	}
+	return bw.Flush()
+}
+
+// Save writes the set to path.
+func (s Set) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := s.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
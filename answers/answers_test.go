@@ -0,0 +1,196 @@
+package answers
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Set
+		err   string
+	}{
+		{
+			name:  "answers",
+			input: "# year day part answer\n2025 1 1 1123\n\n  2024 23 2 co,de,ka,ta  \n2024 1 2 a b\n",
+			want: Set{
+				{2025, 1, 1}:  "1123",
+				{2024, 23, 2}: "co,de,ka,ta",
+				{2024, 1, 2}:  "a b",
+			},
+		},
+		{name: "empty", input: "", want: Set{}},
+		{name: "duplicate", input: "2025 1 1 5\n2025 1 2 6\n2025 1 1 5\n", err: "line 3: duplicate answer for 2025 day 1 part 1"},
+		{name: "too few fields", input: "2025 1 1\n", err: `line 1: want "<year> <day> <part> <answer>", got "2025 1 1"`},
+		{name: "blank answer", input: "# header\n2025 1 1  \t\n", err: "line 2: want"},
+		{name: "bad number", input: "2025 x 1 5\n", err: `line 1: invalid number "x"`},
+		{name: "bad part", input: "2025 1 3 5\n", err: "line 1: invalid part 3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("Parse error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	set := Set{{2025, 2, 1}: "7", {2024, 23, 2}: "co,de", {2025, 1, 2}: "x y"}
+	var b strings.Builder
+	if err := set.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := "# year day part answer\n2024 23 2 co,de\n2025 1 2 x y\n2025 2 1 7\n"
+	if b.String() != want {
+		t.Errorf("Write:\n%s\nwant:\n%s", b.String(), want)
+	}
+	got, err := Parse(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, set) {
+		t.Errorf("Parse(Write) = %v, want %v", got, set)
+	}
+}
+
+func TestRecord(t *testing.T) {
+	golden := Set{{2025, 1, 1}: "10", {2025, 1, 2}: "20", {2025, 2, 1}: "30"}
+	results := []Result{
+		{Key: Key{2025, 1, 1}, Status: Pass, Got: "10", Want: "10"},
+		{Key: Key{2025, 1, 2}, Status: Fail, Got: "21", Want: "20"},
+		{Key: Key{2025, 2, 1}, Status: Error, Want: "30", Err: errors.New("boom")},
+		{Key: Key{2025, 2, 2}, Status: Missing, Got: "40"},
+		{Key: Key{2025, 3, 1}, Status: NoInput},
+	}
+	if n := Record(golden, results); n != 2 {
+		t.Errorf("Record changed %d entries, want 2", n)
+	}
+	want := Set{{2025, 1, 1}: "10", {2025, 1, 2}: "21", {2025, 2, 1}: "30", {2025, 2, 2}: "40"}
+	if !reflect.DeepEqual(golden, want) {
+		t.Errorf("after Record: %v, want %v", golden, want)
+	}
+	if n := Record(golden, results); n != 0 {
+		t.Errorf("second Record changed %d entries, want 0", n)
+	}
+}
+
+// stub answers n and 2n for an input holding the number n. Part 2 is not
+// implemented when n is negative.
+type stub struct{ n int }
+
+func (s *stub) Parse(r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.n, err = strconv.Atoi(strings.TrimSpace(string(b)))
+	return err
+}
+
+func (s *stub) Part1() (aoc.Answer, error) { return aoc.Int(s.n), nil }
+
+func (s *stub) Part2() (aoc.Answer, error) {
+	if s.n < 0 {
+		return aoc.Answer{}, aoc.ErrNotImplemented
+	}
+	return aoc.Int(2 * s.n), nil
+}
+
+func TestVerify(t *testing.T) {
+	// Verify reads the default inputs relative to the working directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	inputs := map[int]string{1: "7", 2: "3", 4: "x", 5: "-1"}
+	var days []aoc.Day
+	for day := 1; day <= 5; day++ {
+		days = append(days, aoc.Day{Year: 2001, Day: day, New: func() aoc.Solver { return new(stub) }})
+		input, ok := inputs[day]
+		if !ok {
+			continue
+		}
+		path := aoc.InputPath(2001, day)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(input+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	golden := Set{
+		{2001, 1, 1}: "7",
+		{2001, 1, 2}: "15",
+		{2001, 3, 1}: "1",
+		{2001, 5, 1}: "-1",
+	}
+
+	type outcome struct {
+		Key
+		Status    Status
+		Got, Want string
+	}
+	want := []outcome{
+		{Key{2001, 1, 1}, Pass, "7", "7"},
+		{Key{2001, 1, 2}, Fail, "14", "15"},
+		{Key{2001, 2, 1}, Missing, "3", ""},
+		{Key{2001, 2, 2}, Missing, "6", ""},
+		{Key{2001, 3, 1}, NoInput, "", "1"},
+		{Key{2001, 3, 2}, NoInput, "", ""},
+		{Key{2001, 4, 1}, Error, "", ""},
+		{Key{2001, 4, 2}, Error, "", ""},
+		{Key{2001, 5, 1}, Pass, "-1", "-1"},
+		{Key{2001, 5, 2}, Unsolved, "", ""},
+	}
+	results := Verify(days, golden)
+	if len(results) != len(want) {
+		t.Fatalf("Verify returned %d results, want %d", len(results), len(want))
+	}
+	for i, r := range results {
+		if got := (outcome{r.Key, r.Status, r.Got, r.Want}); got != want[i] {
+			t.Errorf("result %d = %v %v got %q want %q, want %v %v got %q want %q",
+				i, r.Key, r.Status, r.Got, r.Want, want[i].Key, want[i].Status, want[i].Got, want[i].Want)
+		}
+		switch r.Status {
+		case NoInput:
+			if !errors.Is(r.Err, fs.ErrNotExist) {
+				t.Errorf("%v: err = %v, want fs.ErrNotExist", r.Key, r.Err)
+			}
+		case Error:
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "2001 day 4: parse") {
+				t.Errorf("%v: err = %v, want a parse error", r.Key, r.Err)
+			}
+		default:
+			if r.Err != nil {
+				t.Errorf("%v: unexpected error %v", r.Key, r.Err)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package answers
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+// Status is the outcome of checking one puzzle part.
+type Status int
+
+const (
+	// Pass means the solver reproduced the golden answer.
+	Pass Status = iota
+	// Fail means the solver returned a different answer.
+	Fail
+	// Missing means the solver answered but no golden answer is stored.
+	Missing
+	// Error means parsing or solving failed.
+	Error
+	// NoInput means the day's input file is not available.
+	NoInput
+	// Unsolved means the part has no Go solution.
+	Unsolved
+)
+
+var statusNames = [...]string{"PASS", "FAIL", "MISSING", "ERROR", "NO INPUT", "UNSOLVED"}
+
+func (s Status) String() string { return statusNames[s] }
+
+// Result is the outcome for one puzzle part.
+type Result struct {
+	Key
+	Status Status
+	// Got is the computed answer; empty unless the solver answered.
+	Got string
+	// Want is the golden answer; empty when none is stored.
+	Want string
+	// Err is set for Error and NoInput results.
+	Err error
+}
+
+// Verify runs both parts of every day against its default input and compares
+// the answers with the golden set.
+func Verify(days []aoc.Day, golden Set) []Result {
+	var results []Result
+	for _, d := range days {
+		s, err := d.LoadInput("")
+		for part := 1; part <= 2; part++ {
+			r := Result{Key: Key{d.Year, d.Day, part}}
+			r.Want, _ = golden.Get(d.Year, d.Day, part)
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				r.Status, r.Err = NoInput, err
+			case err != nil:
+				r.Status, r.Err = Error, err
+			default:
+				r.check(s)
+			}
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+func (r *Result) check(s aoc.Solver) {
+	ans, err := aoc.Part(s, r.Part)
+	switch {
+	case errors.Is(err, aoc.ErrNotImplemented):
+		r.Status = Unsolved
+		return
+	case err != nil:
+		r.Status, r.Err = Error, err
+		return
+	}
+	r.Got = ans.String()
+	switch {
+	case r.Want == "":
+		r.Status = Missing
+	case r.Got == r.Want:
+		r.Status = Pass
+	default:
+		r.Status = Fail
+	}
+}
+
+// Record stores every computed answer in results as the new golden value and
+// reports how many entries changed.
+func Record(golden Set, results []Result) int {
+	changed := 0
+	for _, r := range results {
+		if r.Got == "" {
+			continue
+		}
+		if old, ok := golden[r.Key]; !ok || old != r.Got {
+			changed++
+		}
+		golden[r.Key] = r.Got
+	}
+	return changed
+}
@@ -0,0 +1,68 @@
+package aoc
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Kind tells which representation an Answer carries.
+type Kind int
+
+const (
+	KindNone Kind = iota
+	KindInt
+	KindString
+	KindList
+)
+
+// Answer is the typed result of one puzzle part. Puzzles answer with an
+// integer, a free-form string such as a password or an "x,y" coordinate, or
+// a list of integers that is submitted comma separated.
+type Answer struct {
+	kind Kind
+	n    int
+	s    string
+	list []int
+}
+
+// Int returns an integer answer.
+func Int(n int) Answer { return Answer{kind: KindInt, n: n} }
+
+// String returns a string answer.
+func String(s string) Answer { return Answer{kind: KindString, s: s} }
+
+// List returns an answer made of a sequence of integers.
+func List(vals []int) Answer {
+	return Answer{kind: KindList, list: append([]int(nil), vals...)}
+}
+
+// Kind reports the representation of the answer.
+func (a Answer) Kind() Kind { return a.kind }
+
+// Int returns the integer value of an integer answer.
+func (a Answer) Int() (int, bool) { return a.n, a.kind == KindInt }
+
+// List returns a copy of the values of a list answer.
+func (a Answer) List() ([]int, bool) {
+	return append([]int(nil), a.list...), a.kind == KindList
+}
+
+// IsZero reports whether the answer is unset.
+func (a Answer) IsZero() bool { return a.kind == KindNone }
+
+// String formats the answer the way it is submitted on the website.
+func (a Answer) String() string {
+	switch a.kind {
+	case KindInt:
+		return strconv.Itoa(a.n)
+	case KindString:
+		return a.s
+	case KindList:
+		parts := make([]string, len(a.list))
+		for i, v := range a.list {
+			parts[i] = strconv.Itoa(v)
+		}
+		return strings.Join(parts, ",")
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+// Package aoctest runs the published puzzle examples against registered
+// solutions from table-driven tests.
+package aoctest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+// Case is one published example.
+type Case struct {
+	Name string
+	// Input is the example input. A single leading newline is dropped so
+	// examples can start on the line after the opening backquote.
+	Input string
+	// Params overrides puzzle constants, see aoc.Tunable.
+	Params map[string]int
+	// Part1 and Part2 are the expected answers; an empty string skips the
+	// part, for examples published for one part only.
+	Part1, Part2 string
+	// Skip, when set, explains why the example cannot run yet.
+	Skip string
+}
+
+// Run checks every case against the solution registered for year and day.
+// A case with a part that reports aoc.ErrNotImplemented still checks its
+// other part, then shows as skipped so the unchecked answer is visible.
+func Run(t *testing.T, year, day int, cases []Case) {
+	t.Helper()
+	d, ok := aoc.Lookup(year, day)
+	if !ok {
+		t.Fatalf("no solution registered for %d day %d", year, day)
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			if c.Skip != "" {
+				t.Skip(c.Skip)
+			}
+			s := d.New()
+			for name, v := range c.Params {
+				if err := aoc.SetParam(s, name, v); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := s.Parse(strings.NewReader(strings.TrimPrefix(c.Input, "\n"))); err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			var unsolved []string
+			for part, want := range []string{c.Part1, c.Part2} {
+				if want == "" {
+					continue
+				}
+				if !checkPart(t, s, part+1, want) {
+					unsolved = append(unsolved, fmt.Sprintf("part %d", part+1))
+				}
+			}
+			if len(unsolved) > 0 {
+				t.Skipf("%s not solved in Go", strings.Join(unsolved, " and "))
+			}
+		})
+	}
+}
+
+// checkPart checks one part and returns false if it is not solved in Go.
+func checkPart(t *testing.T, s aoc.Solver, part int, want string) bool {
+	t.Helper()
+	got, err := aoc.Part(s, part)
+	if errors.Is(err, aoc.ErrNotImplemented) {
+		return false
+	}
+	if err != nil {
+		t.Errorf("Part%d: %v", part, err)
+	} else if got.String() != want {
+		t.Errorf("Part%d = %v, want %s", part, got, want)
+	}
+	return true
+}
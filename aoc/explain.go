@@ -0,0 +1,24 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+)
+
+// Explainer is implemented by solvers that can show how they reached their
+// answers, such as which records failed a check or one arrangement that
+// works. aoc run --explain prints it after the answers.
+type Explainer interface {
+	// Explain writes a human-readable report. Like the parts it must not
+	// modify the parsed state.
+	Explain(w io.Writer) error
+}
+
+// Explain writes the report of s, or returns an error if s has none.
+func Explain(s Solver, w io.Writer) error {
+	e, ok := s.(Explainer)
+	if !ok {
+		return fmt.Errorf("solver has no explanation")
+	}
+	return e.Explain(w)
+}
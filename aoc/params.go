@@ -0,0 +1,36 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Tunable is implemented by solvers whose answers depend on puzzle constants
+// that differ between the published example and the real input, such as grid
+// sizes, step counts or thresholds. New must set the real-input defaults.
+type Tunable interface {
+	// Params returns a pointer to each constant, keyed by name.
+	Params() map[string]*int
+}
+
+// SetParam changes the named constant of s. It must be called before the
+// parts run.
+func SetParam(s Solver, name string, value int) error {
+	t, ok := s.(Tunable)
+	if !ok {
+		return fmt.Errorf("solver has no parameters (setting %q)", name)
+	}
+	params := t.Params()
+	p, ok := params[name]
+	if !ok {
+		names := make([]string, 0, len(params))
+		for n := range params {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown parameter %q (have %s)", name, strings.Join(names, ", "))
+	}
+	*p = value
+	return nil
+}
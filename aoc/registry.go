@@ -0,0 +1,156 @@
+// Package aoc holds the registry that ties every puzzle solution in this
+// repository to its year and day, and the Solver contract they implement, so
+// a single command or any other tool can run them as a library.
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+var (
+	// ErrNotImplemented is returned when a part has no Go solution yet.
+	ErrNotImplemented = errors.New("not implemented")
+	// ErrEmptyInput is returned by Parse when the input holds no puzzle.
+	ErrEmptyInput = errors.New("empty input")
+)
+
+// AsEmptyInput returns ErrEmptyInput if err is empty, the error a helper
+// package such as grid reports for input with nothing in it, and err
+// otherwise.
+func AsEmptyInput(err, empty error) error {
+	if errors.Is(err, empty) {
+		return ErrEmptyInput
+	}
+	return err
+}
+
+// Solver is implemented by every day. Parse reads the puzzle input once; the
+// parts then compute their answers from the parsed state. Parts must not
+// modify that state, so they can be run repeatedly and in any order.
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// Day describes a registered solution.
+type Day struct {
+	Year, Day int
+	// New returns a fresh solver for one input.
+	New func() Solver
+}
+
+type key struct{ year, day int }
+
+var registry = make(map[key]Day)
+
+// Register adds a solution to the registry. It is meant to be called from
+// the init function of each day package and panics on duplicates.
+func Register(d Day) {
+	k := key{d.Year, d.Day}
+	if _, exists := registry[k]; exists {
+		panic(fmt.Sprintf("aoc: %d day %d registered twice", d.Year, d.Day))
+	}
+	registry[k] = d
+}
+
+// Lookup returns the solution registered for year and day.
+func Lookup(year, day int) (Day, bool) {
+	d, ok := registry[key{year, day}]
+	return d, ok
+}
+
+// Days returns every registered solution ordered by year and day.
+func Days() []Day {
+	days := make([]Day, 0, len(registry))
+	for _, d := range registry {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].Year != days[j].Year {
+			return days[i].Year < days[j].Year
+		}
+		return days[i].Day < days[j].Day
+	})
+	return days
+}
+
+// Dir returns the directory holding the solution for year and day, relative
+// to the repository root.
+func Dir(year, day int) string {
+	return filepath.Join(strconv.Itoa(year), fmt.Sprintf("day%02d", day))
+}
+
+// InputFile is the name of the puzzle input inside a day directory.
+const InputFile = "input.txt"
+
+// InputPath returns the canonical location of the puzzle input for year and
+// day, relative to the repository root.
+func InputPath(year, day int) string {
+	return filepath.Join(Dir(year, day), InputFile)
+}
+
+// InputPath returns the default input file for the day.
+func (d Day) InputPath() string {
+	return InputPath(d.Year, d.Day)
+}
+
+// String returns the "<year> day <day>" label used in messages.
+func (d Day) String() string {
+	return fmt.Sprintf("%d day %d", d.Year, d.Day)
+}
+
+// Part runs part 1 or 2 of an already parsed solver.
+func Part(s Solver, n int) (Answer, error) {
+	switch n {
+	case 1:
+		return s.Part1()
+	case 2:
+		return s.Part2()
+	}
+	return Answer{}, fmt.Errorf("invalid part %d", n)
+}
+
+// Load returns a solver for the day that has parsed r.
+func (d Day) Load(r io.Reader) (Solver, error) {
+	return d.load(r, "")
+}
+
+// LoadFile is Load for the input file at path. Parse errors name the file.
+func (d Day) LoadFile(path string) (Solver, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return d.load(f, path)
+}
+
+func (d Day) load(r io.Reader, path string) (Solver, error) {
+	s := d.New()
+	if err := s.Parse(r); err != nil {
+		if path != "" {
+			err = parse.WithFile(err, path)
+		}
+		return nil, fmt.Errorf("%v: parse: %w", d, err)
+	}
+	return s, nil
+}
+
+// LoadInput parses the input file at path, or the day's default input when
+// path is empty. A missing file is reported with an error wrapping
+// fs.ErrNotExist.
+func (d Day) LoadInput(path string) (Solver, error) {
+	if path == "" {
+		path = d.InputPath()
+	}
+	return d.LoadFile(path)
+}
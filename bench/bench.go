@@ -0,0 +1,151 @@
+// Package bench times the parse step and both parts of the registered
+// solutions with testing.Benchmark, stores the results as JSON or CSV and
+// compares them against a saved baseline.
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+// Phase is the timed step of a solution.
+type Phase string
+
+const (
+	Parse Phase = "parse"
+	Part1 Phase = "part1"
+	Part2 Phase = "part2"
+)
+
+// Result is the measurement of one phase of one day.
+type Result struct {
+	Year  int   `json:"year"`
+	Day   int   `json:"day"`
+	Phase Phase `json:"phase"`
+	// Runs is how many times the benchmark was repeated; the per-op figures
+	// are the median over the runs.
+	Runs        int   `json:"runs"`
+	Iterations  int   `json:"iterations"`
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+func (r Result) key() string {
+	return fmt.Sprintf("%d day %d %s", r.Year, r.Day, r.Phase)
+}
+
+// Skip explains why a day or part was not benchmarked.
+type Skip struct {
+	Day    aoc.Day
+	Phase  Phase
+	Reason error
+}
+
+// Options control a benchmark run.
+type Options struct {
+	// Count is how many times each benchmark is repeated; at least 1.
+	Count int
+	// Phases limits the run to some phases; all of them when empty.
+	Phases []Phase
+}
+
+func (o Options) wants(p Phase) bool {
+	if len(o.Phases) == 0 {
+		return true
+	}
+	for _, q := range o.Phases {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Run benchmarks the given days on their default inputs. Days whose input is
+// missing and parts that are not solved in Go are reported as skipped;
+// solutions that fail are an error.
+func Run(days []aoc.Day, opts Options) ([]Result, []Skip, error) {
+	if opts.Count < 1 {
+		opts.Count = 1
+	}
+	var results []Result
+	var skipped []Skip
+	for _, d := range days {
+		input, err := os.ReadFile(d.InputPath())
+		if errors.Is(err, fs.ErrNotExist) {
+			skipped = append(skipped, Skip{d, Parse, err})
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		s, err := d.Load(bytes.NewReader(input))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if opts.wants(Parse) {
+			results = append(results, measure(d, Parse, opts.Count, func() error {
+				return d.New().Parse(bytes.NewReader(input))
+			}))
+		}
+		for part, phase := range []Phase{Part1, Part2} {
+			if !opts.wants(phase) {
+				continue
+			}
+			// Run once outside the timer so unsolved and failing parts are
+			// reported instead of benchmarked.
+			if _, err := aoc.Part(s, part+1); errors.Is(err, aoc.ErrNotImplemented) {
+				skipped = append(skipped, Skip{d, phase, err})
+				continue
+			} else if err != nil {
+				return nil, nil, fmt.Errorf("%v %s: %w", d, phase, err)
+			}
+			results = append(results, measure(d, phase, opts.Count, func() error {
+				_, err := aoc.Part(s, part+1)
+				return err
+			}))
+		}
+	}
+	return results, skipped, nil
+}
+
+// measure runs fn under testing.Benchmark count times.
+func measure(d aoc.Day, phase Phase, count int, fn func() error) Result {
+	var runs []testing.BenchmarkResult
+	for i := 0; i < count; i++ {
+		runs = append(runs, testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			for j := 0; j < b.N; j++ {
+				if err := fn(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}))
+	}
+	sort.Slice(runs, func(i, j int) bool { return runs[i].NsPerOp() < runs[j].NsPerOp() })
+	median := runs[len(runs)/2]
+
+	iterations := 0
+	for _, r := range runs {
+		iterations += r.N
+	}
+	return Result{
+		Year:        d.Year,
+		Day:         d.Day,
+		Phase:       phase,
+		Runs:        count,
+		Iterations:  iterations,
+		NsPerOp:     median.NsPerOp(),
+		AllocsPerOp: median.AllocsPerOp(),
+		BytesPerOp:  median.AllocedBytesPerOp(),
+	}
+}
@@ -0,0 +1,54 @@
+package bench
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+var sample = []Result{
+	{Year: 2025, Day: 8, Phase: Parse, Runs: 3, Iterations: 900, NsPerOp: 120000, AllocsPerOp: 1012, BytesPerOp: 65536},
+	{Year: 2025, Day: 8, Phase: Part1, Runs: 3, Iterations: 30, NsPerOp: 41000000, AllocsPerOp: 40, BytesPerOp: 16000000},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, f := range []struct {
+		name  string
+		write func(io.Writer, []Result) error
+		read  func(io.Reader) ([]Result, error)
+	}{
+		{"json", WriteJSON, ReadJSON},
+		{"csv", WriteCSV, ReadCSV},
+	} {
+		var buf bytes.Buffer
+		if err := f.write(&buf, sample); err != nil {
+			t.Fatalf("%s: write: %v", f.name, err)
+		}
+		got, err := f.read(&buf)
+		if err != nil {
+			t.Fatalf("%s: read: %v", f.name, err)
+		}
+		if !reflect.DeepEqual(got, sample) {
+			t.Errorf("%s: round trip = %+v, want %+v", f.name, got, sample)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	now := []Result{
+		{Year: 2025, Day: 8, Phase: Parse, NsPerOp: 130000},   // +8%
+		{Year: 2025, Day: 8, Phase: Part1, NsPerOp: 82000000}, // +100%
+		{Year: 2025, Day: 8, Phase: Part2, NsPerOp: 1000},     // no baseline
+	}
+	changes := Compare(sample, now, 0.1)
+	if len(changes) != 2 {
+		t.Fatalf("got %d changes, want 2", len(changes))
+	}
+	if changes[0].Slower || !changes[1].Slower {
+		t.Errorf("Slower = %v, %v; want false, true", changes[0].Slower, changes[1].Slower)
+	}
+	if changes[1].Ratio != 2 {
+		t.Errorf("Ratio = %v, want 2", changes[1].Ratio)
+	}
+}
@@ -0,0 +1,37 @@
+package bench
+
+// Change compares one phase against its baseline.
+type Change struct {
+	Result
+	// Base is the baseline time per op.
+	Base int64
+	// Ratio is NsPerOp / Base.
+	Ratio float64
+	// Slower is set when Ratio exceeds 1 + the threshold.
+	Slower bool
+}
+
+// Compare matches results with the baseline by year, day and phase. A phase
+// is flagged as slower when its time per op grew by more than threshold, a
+// fraction such as 0.2 for 20%. Results without a baseline are left out.
+func Compare(baseline, results []Result, threshold float64) []Change {
+	base := make(map[string]Result, len(baseline))
+	for _, b := range baseline {
+		base[b.key()] = b
+	}
+	var changes []Change
+	for _, r := range results {
+		b, ok := base[r.key()]
+		if !ok || b.NsPerOp <= 0 {
+			continue
+		}
+		ratio := float64(r.NsPerOp) / float64(b.NsPerOp)
+		changes = append(changes, Change{
+			Result: r,
+			Base:   b.NsPerOp,
+			Ratio:  ratio,
+			Slower: ratio > 1+threshold,
+		})
+	}
+	return changes
+}
@@ -0,0 +1,99 @@
+package bench
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var csvHeader = []string{"year", "day", "phase", "runs", "iterations", "ns_per_op", "allocs_per_op", "bytes_per_op"}
+
+// WriteJSON writes results as an indented JSON array.
+func WriteJSON(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+// WriteCSV writes results as CSV with a header row.
+func WriteCSV(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	cw.Write(csvHeader)
+	for _, r := range results {
+		cw.Write([]string{
+			strconv.Itoa(r.Year), strconv.Itoa(r.Day), string(r.Phase),
+			strconv.Itoa(r.Runs), strconv.Itoa(r.Iterations),
+			strconv.FormatInt(r.NsPerOp, 10), strconv.FormatInt(r.AllocsPerOp, 10),
+			strconv.FormatInt(r.BytesPerOp, 10),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadJSON reads results written by WriteJSON.
+func ReadJSON(r io.Reader) ([]Result, error) {
+	var results []Result
+	if err := json.NewDecoder(r).Decode(&results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ReadCSV reads results written by WriteCSV.
+func ReadCSV(r io.Reader) ([]Result, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(csvHeader, ",") {
+		return nil, fmt.Errorf("missing header %q", strings.Join(csvHeader, ","))
+	}
+	var results []Result
+	for i, rec := range records[1:] {
+		var nums [7]int64
+		for j, k := range []int{0, 1, 3, 4, 5, 6, 7} {
+			n, err := strconv.ParseInt(rec[k], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s: %w", i+2, csvHeader[k], err)
+			}
+			nums[j] = n
+		}
+		results = append(results, Result{
+			Year:        int(nums[0]),
+			Day:         int(nums[1]),
+			Phase:       Phase(rec[2]),
+			Runs:        int(nums[2]),
+			Iterations:  int(nums[3]),
+			NsPerOp:     nums[4],
+			AllocsPerOp: nums[5],
+			BytesPerOp:  nums[6],
+		})
+	}
+	return results, nil
+}
+
+// Load reads a results file, as CSV when its name ends in .csv and as JSON
+// otherwise.
+func Load(path string) ([]Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var results []Result
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		results, err = ReadCSV(f)
+	} else {
+		results, err = ReadJSON(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return results, nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/bench"
+)
+
+func benchCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "table", "output format: table, csv or json")
+	out := fs.String("out", "", "write the results to `file` instead of stdout")
+	count := fs.Int("count", 1, "run each benchmark `n` times and report the median")
+	benchtime := fs.Duration("benchtime", time.Second, "target run time of each benchmark")
+	phase := fs.String("phase", "", "only time one phase: parse, part1 or part2")
+	baseline := fs.String("baseline", "", "compare against results saved with --out (JSON, or CSV by extension)")
+	threshold := fs.Float64("threshold", 0.2, "fraction by which a phase may slow down before it is flagged")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	days, err := selectDays(positional)
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "table", "csv", "json":
+	default:
+		return fmt.Errorf("%w: unknown format %q", errUsage, *format)
+	}
+	opts := bench.Options{Count: *count}
+	if *phase != "" {
+		p := bench.Phase(*phase)
+		if p != bench.Parse && p != bench.Part1 && p != bench.Part2 {
+			return fmt.Errorf("%w: unknown phase %q", errUsage, *phase)
+		}
+		opts.Phases = []bench.Phase{p}
+	}
+	var base []bench.Result
+	if *baseline != "" {
+		if base, err = bench.Load(*baseline); err != nil {
+			return err
+		}
+	}
+
+	// testing.Benchmark reads its run time from the test flags.
+	testing.Init()
+	if err := flag.Set("test.benchtime", benchtime.String()); err != nil {
+		return err
+	}
+	results, skipped, err := bench.Run(days, opts)
+	if err != nil {
+		return err
+	}
+	for _, s := range skipped {
+		fmt.Fprintf(stderr, "skipping %v %s: %v\n", s.Day, s.Phase, s.Reason)
+	}
+
+	if err := writeBench(stdout, *out, *format, results); err != nil {
+		return err
+	}
+
+	if base == nil {
+		return nil
+	}
+	// Keep machine-readable output on stdout clean.
+	report := stdout
+	if *format != "table" && *out == "" {
+		report = stderr
+	} else if *out == "" {
+		fmt.Fprintln(report)
+	}
+	changes := bench.Compare(base, results, *threshold)
+	slower := printChanges(report, changes)
+	if slower > 0 {
+		return fmt.Errorf("%d phases slowed down by more than %.0f%%", slower, *threshold*100)
+	}
+	return nil
+}
+
+// writeBench writes results to path, or to stdout when path is empty.
+func writeBench(stdout io.Writer, path, format string, results []bench.Result) error {
+	if path == "" {
+		return encodeBench(stdout, format, results)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := encodeBench(f, format, results); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func encodeBench(w io.Writer, format string, results []bench.Result) error {
+	switch format {
+	case "csv":
+		return bench.WriteCSV(w, results)
+	case "json":
+		return bench.WriteJSON(w, results)
+	}
+	printBench(w, results)
+	return nil
+}
+
+func printBench(w io.Writer, results []bench.Result) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPHASE\tRUNS\tTIME/OP\tALLOCS/OP\tBYTES/OP\t")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%d\t%v\t%d\t%d\t\n",
+			r.Year, r.Day, r.Phase, r.Runs, time.Duration(r.NsPerOp), r.AllocsPerOp, r.BytesPerOp)
+	}
+	tw.Flush()
+}
+
+// printChanges prints the comparison with the baseline and returns the number
+// of phases flagged as slower.
+func printChanges(w io.Writer, changes []bench.Change) int {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPHASE\tBASE\tNOW\tDELTA\t")
+	slower := 0
+	for _, c := range changes {
+		flag := ""
+		if c.Slower {
+			flag = "SLOWER"
+			slower++
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%v\t%v\t%+.1f%%\t%s\n",
+			c.Year, c.Day, c.Phase, time.Duration(c.Base), time.Duration(c.NsPerOp), (c.Ratio-1)*100, flag)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\n%d of %d phases slower than the baseline\n", slower, len(changes))
+	return slower
+}
@@ -0,0 +1,44 @@
+package main
+
+// Every solution registers itself with the aoc package from its init
+// function, so importing the day packages is all the runner needs.
+import (
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day01"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day02"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day03"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day04"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day05"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day06"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day07"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day08"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day09"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day10"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day11"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day12"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day13"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day14"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day15"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day16"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day17"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day18"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day19"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day20"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day21"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day22"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day23"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day24"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2024/day25"
+
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day01"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day02"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day03"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day04"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day05"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day06"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day07"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day08"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day09"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day10"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day11"
+	_ "github.com/shubhamsugara22/AdventOfCode-202X/2025/day12"
+)
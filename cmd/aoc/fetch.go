@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/site"
+)
+
+func fetchCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	baseURL := fs.String("base-url", site.DefaultBaseURL, "Advent of Code site to download from")
+	out := fs.String("out", "", "where to store the input (default: the input in the day directory)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	year, day, err := parseYearDay(positional)
+	if err != nil {
+		return err
+	}
+	path := *out
+	if path == "" {
+		path = aoc.InputPath(year, day)
+	}
+
+	// A cached input needs no token; Fetch reports a missing one only when
+	// it has to download.
+	session, err := site.LoadSession()
+	if err != nil && !errors.Is(err, site.ErrNoSession) {
+		return err
+	}
+	c := &site.Client{BaseURL: *baseURL, Session: session}
+	downloaded, err := site.Fetch(context.Background(), c, year, day, path)
+	if err != nil {
+		return err
+	}
+	if downloaded {
+		fmt.Fprintf(stdout, "downloaded %s\n", path)
+	} else {
+		fmt.Fprintf(stdout, "%s already present, not downloading\n", path)
+	}
+	return nil
+}
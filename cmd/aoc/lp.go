@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/ilp"
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func lpCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("lp", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	relax := fs.Bool("relax", false, "ignore int declarations and solve the linear relaxation")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("%w: expected at most one file", errUsage)
+	}
+
+	var p *ilp.Problem
+	if len(positional) == 0 || positional[0] == "-" {
+		p, err = ilp.ParseLP(os.Stdin)
+	} else {
+		var f *os.File
+		if f, err = os.Open(positional[0]); err != nil {
+			return err
+		}
+		p, err = ilp.ParseLP(f)
+		f.Close()
+		err = parse.WithFile(err, positional[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	solve := p.Solve
+	if *relax {
+		solve = p.SolveRelaxation
+	}
+	sol, err := solve()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "objective: %s\n", sol.Value.RatString())
+	for i, v := range p.Vars {
+		fmt.Fprintf(stdout, "%s = %s\n", v.Name, sol.X[i].RatString())
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Command aoc runs the Advent of Code solutions in this repository.
+//
+// Usage:
+//
+//	aoc run <year> <day> [--part 1|2] [--input path] [--param name=value] [--explain]
+//	aoc verify [year [day]] [--answers file] [--record]
+//	aoc bench [year [day]] [--format table|csv|json] [--out file] [--baseline file]
+//	aoc fetch <year> <day> [--base-url url] [--out path]
+//	aoc submit <year> <day> <part> [answer] [--history file]
+//	aoc new <year> <day>
+//	aoc lp [file] [--relax]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+)
+
+// errUsage marks errors caused by bad command-line arguments.
+var errUsage = errors.New("invalid arguments")
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdout, stderr io.Writer) error
+}
+
+var commands = []command{
+	{"run", "run <year> <day> [--part 1|2] [--input path] [--param name=value] [--explain]", runCmd},
+	{"verify", "verify [year [day]] [--answers file] [--record]", verifyCmd},
+	{"bench", "bench [year [day]] [--format table|csv|json] [--out file] [--baseline file]", benchCmd},
+	{"fetch", "fetch <year> <day> [--base-url url] [--out path]", fetchCmd},
+	{"submit", "submit <year> <day> <part> [answer] [--history file]", submitCmd},
+	{"new", "new <year> <day>", newCmd},
+	{"lp", "lp [file] [--relax]", lpCmd},
+}
+
+func main() {
+	os.Exit(realMain(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func realMain(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return 2
+	}
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		err := c.run(args[1:], stdout, stderr)
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.Is(err, errUsage):
+			fmt.Fprintf(stderr, "aoc: %v\nusage: aoc %s\n", err, c.usage)
+			return 2
+		default:
+			fmt.Fprintf(stderr, "aoc: %v\n", err)
+			return 1
+		}
+	}
+	fmt.Fprintf(stderr, "aoc: unknown command %q\n", args[0])
+	printUsage(stderr)
+	return 2
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  aoc %s\n", c.usage)
+	}
+}
+
+// parseArgs parses flags that may appear before, between or after the
+// positional arguments and returns the positional ones.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// parseYearDay converts the <year> <day> arguments.
+func parseYearDay(args []string) (int, int, error) {
+	if len(args) != 2 {
+		return 0, 0, fmt.Errorf("%w: expected <year> <day>", errUsage)
+	}
+	year, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: invalid year %q", errUsage, args[0])
+	}
+	day, err := strconv.Atoi(args[1])
+	if err != nil || day < 1 || day > 25 {
+		return 0, 0, fmt.Errorf("%w: invalid day %q", errUsage, args[1])
+	}
+	return year, day, nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+func runCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	part := fs.Int("part", 0, "part to run (1 or 2); both when omitted")
+	input := fs.String("input", "", "input file, or - for standard input (default: the input in the day directory)")
+	explain := fs.Bool("explain", false, "print the solver's explanation of its answers")
+	var params []param
+	fs.Func("param", "set a puzzle constant as `name=value`, e.g. size=7 for an example (repeatable)", func(v string) error {
+		p, err := parseParam(v)
+		if err != nil {
+			return err
+		}
+		params = append(params, p)
+		return nil
+	})
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	year, day, err := parseYearDay(positional)
+	if err != nil {
+		return err
+	}
+	if *part != 0 && *part != 1 && *part != 2 {
+		return fmt.Errorf("%w: --part must be 1 or 2", errUsage)
+	}
+
+	d, ok := aoc.Lookup(year, day)
+	if !ok {
+		return fmt.Errorf("no solution registered for %d day %d", year, day)
+	}
+	var s aoc.Solver
+	if *input == "-" {
+		s, err = d.Load(os.Stdin)
+	} else {
+		s, err = d.LoadInput(*input)
+	}
+	if err != nil {
+		return err
+	}
+	for _, p := range params {
+		if err := aoc.SetParam(s, p.name, p.value); err != nil {
+			return fmt.Errorf("%w: %v: %v", errUsage, d, err)
+		}
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+	solved := 0
+	for _, n := range parts {
+		ans, err := aoc.Part(s, n)
+		if errors.Is(err, aoc.ErrNotImplemented) && *part == 0 {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%v part %d: %w", d, n, err)
+		}
+		fmt.Fprintf(stdout, "Part %d: %v\n", n, ans)
+		solved++
+	}
+	if solved == 0 {
+		return fmt.Errorf("%v: %w", d, aoc.ErrNotImplemented)
+	}
+	if *explain {
+		if err := aoc.Explain(s, stdout); err != nil {
+			return fmt.Errorf("%v: %w", d, err)
+		}
+	}
+	return nil
+}
+
+type param struct {
+	name  string
+	value int
+}
+
+func parseParam(s string) (param, error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || name == "" {
+		return param{}, errors.New("want name=value")
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return param{}, fmt.Errorf("value of %s must be an integer", name)
+	}
+	return param{name, n}, nil
+}
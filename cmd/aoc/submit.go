@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/answers"
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+	"github.com/shubhamsugara22/AdventOfCode-202X/site"
+)
+
+func submitCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	baseURL := fs.String("base-url", site.DefaultBaseURL, "Advent of Code site to submit to")
+	historyFile := fs.String("history", site.DefaultHistory, "guess history file")
+	answersFile := fs.String("answers", answers.DefaultFile, "golden answers file; correct answers are stored there")
+	input := fs.String("input", "", "input file for computing the answer (default: the input in the day directory)")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 3 && len(positional) != 4 {
+		return fmt.Errorf("%w: expected <year> <day> <part> [answer]", errUsage)
+	}
+	year, day, err := parseYearDay(positional[:2])
+	if err != nil {
+		return err
+	}
+	part, err := strconv.Atoi(positional[2])
+	if err != nil || (part != 1 && part != 2) {
+		return fmt.Errorf("%w: part must be 1 or 2", errUsage)
+	}
+
+	var answer string
+	if len(positional) == 4 {
+		answer = positional[3]
+	} else {
+		d, ok := aoc.Lookup(year, day)
+		if !ok {
+			return fmt.Errorf("no solution registered for %d day %d; pass the answer explicitly", year, day)
+		}
+		s, err := d.LoadInput(*input)
+		if err != nil {
+			return err
+		}
+		ans, err := aoc.Part(s, part)
+		if err != nil {
+			return fmt.Errorf("%v part %d: %w", d, part, err)
+		}
+		answer = ans.String()
+	}
+	if answer == "" {
+		return fmt.Errorf("%w: empty answer", errUsage)
+	}
+
+	history, err := site.LoadHistory(*historyFile)
+	if err != nil {
+		return err
+	}
+	if err := history.Check(year, day, part, answer); err != nil {
+		return err
+	}
+	session, err := site.LoadSession()
+	if err != nil {
+		return err
+	}
+
+	c := &site.Client{BaseURL: *baseURL, Session: session}
+	v, err := c.Submit(context.Background(), year, day, part, answer)
+	if err != nil {
+		return err
+	}
+	g := site.Guess{Time: time.Now(), Year: year, Day: day, Part: part, Outcome: v.Outcome, Answer: answer}
+	if err := site.AppendHistory(*historyFile, g); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, v.Message)
+	if v.Wait > 0 {
+		fmt.Fprintf(stdout, "wait %v before the next submission\n", v.Wait)
+	}
+	if v.Outcome != site.Correct {
+		return fmt.Errorf("%d day %d part %d: %s not accepted: %v", year, day, part, answer, v.Outcome)
+	}
+
+	golden, err := answers.Load(*answersFile)
+	if err != nil {
+		return err
+	}
+	golden.Put(year, day, part, answer)
+	if err := golden.Save(*answersFile); err != nil {
+		return err
+	}
+	fmt.Fprintf(stdout, "recorded %s in %s\n", answer, *answersFile)
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/answers"
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+func verifyCmd(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	file := fs.String("answers", answers.DefaultFile, "golden answers file")
+	record := fs.Bool("record", false, "store the current outputs as the new golden answers")
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		return err
+	}
+	days, err := selectDays(positional)
+	if err != nil {
+		return err
+	}
+
+	golden, err := answers.Load(*file)
+	if err != nil {
+		return err
+	}
+	results := answers.Verify(days, golden)
+	printResults(stdout, results)
+
+	if *record {
+		changed := answers.Record(golden, results)
+		if err := golden.Save(*file); err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "recorded %d changed answers in %s\n", changed, *file)
+		return nil
+	}
+
+	bad := 0
+	for _, r := range results {
+		if r.Status == answers.Fail || r.Status == answers.Error {
+			bad++
+		}
+	}
+	if bad > 0 {
+		return fmt.Errorf("%d parts failed verification", bad)
+	}
+	return nil
+}
+
+// selectDays returns the registered days matching the optional <year> and
+// <day> arguments.
+func selectDays(args []string) ([]aoc.Day, error) {
+	if len(args) > 2 {
+		return nil, fmt.Errorf("%w: expected [year [day]]", errUsage)
+	}
+	var filter [2]int
+	for i, a := range args {
+		n, err := strconv.Atoi(a)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid number %q", errUsage, a)
+		}
+		filter[i] = n
+	}
+	var days []aoc.Day
+	for _, d := range aoc.Days() {
+		if (filter[0] == 0 || d.Year == filter[0]) && (filter[1] == 0 || d.Day == filter[1]) {
+			days = append(days, d)
+		}
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no solutions registered for %v", args)
+	}
+	return days, nil
+}
+
+func printResults(w io.Writer, results []answers.Result) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPART\tSTATUS\tGOT\tWANT")
+	counts := make(map[answers.Status]int)
+	for _, r := range results {
+		counts[r.Status]++
+		got := r.Got
+		if r.Err != nil && r.Status == answers.Error {
+			got = r.Err.Error()
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%v\t%s\t%s\n", r.Year, r.Day, r.Part, r.Status, got, r.Want)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\npass %d, fail %d, missing %d, error %d, no input %d, unsolved %d\n",
+		counts[answers.Pass], counts[answers.Fail], counts[answers.Missing],
+		counts[answers.Error], counts[answers.NoInput], counts[answers.Unsolved])
+}
@@ -0,0 +1,97 @@
+// Package cycle finds where an iterated simulation starts repeating. A
+// simulation is a start state x0 and a step function f; the states x0,
+// f(x0), f(f(x0)), ... are steps 0, 1, 2, .... If the states are finite in
+// number they eventually repeat, and from then on the simulation runs round
+// a loop, which lets step n be found for any n without running n steps.
+package cycle
+
+// Cycle describes a repeating simulation: step Start is the first state on
+// the loop, and the state at step Start+Length is the same again. A
+// simulation that settles on a fixed point has Length 1.
+type Cycle struct {
+	Start, Length int
+}
+
+// Index returns the earliest step whose state is the same as step n's.
+func (c Cycle) Index(n int) int {
+	if n < c.Start {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Length
+}
+
+// Floyd finds the cycle with Floyd's tortoise and hare. It keeps only a
+// few states, and calls f about three times per step up to the end of the
+// first lap of the loop.
+func Floyd[S comparable](x0 S, f func(S) S) Cycle {
+	tortoise, hare := f(x0), f(f(x0))
+	for tortoise != hare {
+		tortoise, hare = f(tortoise), f(f(hare))
+	}
+	start := 0
+	tortoise = x0
+	for tortoise != hare {
+		tortoise, hare = f(tortoise), f(hare)
+		start++
+	}
+	length := 1
+	for hare = f(tortoise); tortoise != hare; hare = f(hare) {
+		length++
+	}
+	return Cycle{start, length}
+}
+
+// Brent finds the cycle with Brent's algorithm. Like Floyd it keeps only a
+// few states, but it calls f fewer times: the hare runs ahead in laps of
+// doubling length, and the tortoise jumps to it after each.
+func Brent[S comparable](x0 S, f func(S) S) Cycle {
+	c, _, _ := brent(x0, f, -1)
+	return c
+}
+
+// brent is Brent with a step limit. If the hare reaches step limit before
+// the cycle is found, it returns the state there and false instead.
+func brent[S comparable](x0 S, f func(S) S, limit int) (Cycle, S, bool) {
+	if limit == 0 {
+		return Cycle{}, x0, false
+	}
+	power, length := 1, 1
+	tortoise, hare := x0, f(x0)
+	for step := 1; tortoise != hare; step++ {
+		if step == limit {
+			return Cycle{}, hare, false
+		}
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = f(hare)
+		length++
+	}
+	tortoise, hare = x0, x0
+	for i := 0; i < length; i++ {
+		hare = f(hare)
+	}
+	start := 0
+	for tortoise != hare {
+		tortoise, hare = f(tortoise), f(hare)
+		start++
+	}
+	return Cycle{start, length}, hare, true
+}
+
+// Nth returns the state at step n. It stops at step n if no state has
+// repeated by then; otherwise, after finding the cycle, it takes fewer than
+// Start+Length more steps, however large n is.
+func Nth[S comparable](x0 S, f func(S) S, n int) S {
+	c, x, ok := brent(x0, f, n)
+	if !ok {
+		return x
+	}
+	steps := c.Index(n)
+	for i := 0; i < steps; i++ {
+		x0 = f(x0)
+	}
+	return x0
+}
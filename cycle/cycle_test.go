@@ -0,0 +1,102 @@
+package cycle
+
+import "testing"
+
+// naive finds the cycle by remembering every state in a slice.
+func naive(x0 int, f func(int) int) Cycle {
+	var seen []int
+	for x := x0; ; x = f(x) {
+		for i, y := range seen {
+			if x == y {
+				return Cycle{i, len(seen) - i}
+			}
+		}
+		seen = append(seen, x)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	for m := 1; m < 60; m++ {
+		f := func(x int) int { return (x*x + 1) % m }
+		for x0 := 0; x0 < m; x0++ {
+			want := naive(x0, f)
+			if got := Floyd(x0, f); got != want {
+				t.Fatalf("mod %d from %d: Floyd = %+v, want %+v", m, x0, got, want)
+			}
+			if got := Brent(x0, f); got != want {
+				t.Fatalf("mod %d from %d: Brent = %+v, want %+v", m, x0, got, want)
+			}
+			got, states := Find(x0, f, func(x int) int { return x })
+			if got != want || len(states) != want.Start+want.Length {
+				t.Fatalf("mod %d from %d: Find = %+v with %d states, want %+v", m, x0, got, len(states), want)
+			}
+		}
+	}
+}
+
+func TestNth(t *testing.T) {
+	f := func(x int) int { return (x*x + 1) % 1000 }
+	x := 3
+	for n := 0; n < 300; n++ {
+		if got := Nth(3, f, n); got != x {
+			t.Fatalf("Nth(%d) = %d, want %d", n, got, x)
+		}
+		if got := NthFunc(3, f, func(x int) int { return x }, n); got != x {
+			t.Fatalf("NthFunc(%d) = %d, want %d", n, got, x)
+		}
+		x = f(x)
+	}
+
+	// A robot crossing a 101-wide room, as in 2024 day 14, is back where it
+	// started every 101 seconds.
+	walk := func(col int) int { return (col + 3) % 101 }
+	if c := Brent(7, walk); c != (Cycle{0, 101}) {
+		t.Errorf("robot cycle = %+v", c)
+	}
+	if got := Nth(7, walk, 1_000_000_000); got != (7+3*1_000_000_000)%101 {
+		t.Errorf("robot after 1e9 seconds at %d", got)
+	}
+
+	// A counter never repeats, so Nth must stop at step n.
+	calls := 0
+	count := func(x int) int { calls++; return x + 1 }
+	for _, n := range []int{0, 1, 2, 1000} {
+		calls = 0
+		if got := Nth(0, count, n); got != n || calls != n {
+			t.Errorf("counter: Nth(%d) = %d after %d calls", n, got, calls)
+		}
+	}
+}
+
+func TestFixedPoint(t *testing.T) {
+	// Halving settles on 0, which is a cycle of length 1.
+	halve := func(x int) int { return x / 2 }
+	if c := Brent(100, halve); c != (Cycle{7, 1}) {
+		t.Errorf("halving 100: %+v", c)
+	}
+	if got := Nth(100, halve, 1<<40); got != 0 {
+		t.Errorf("halving 100 forever gives %d", got)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory[string]()
+	for i, k := range []string{"a", "b", "c", "d"} {
+		if _, ok := h.Add(k); ok {
+			t.Fatalf("step %d repeated", i)
+		}
+	}
+	c, ok := h.Add("b")
+	if !ok || c != (Cycle{1, 3}) {
+		t.Errorf("Add(b) = %+v, %t", c, ok)
+	}
+	if h.Len() != 4 {
+		t.Errorf("Len = %d after a repeat", h.Len())
+	}
+	if step, ok := h.Step("c"); !ok || step != 2 {
+		t.Errorf("Step(c) = %d, %t", step, ok)
+	}
+	if got := c.Index(10); got != 1 {
+		t.Errorf("Index(10) = %d, want 1", got)
+	}
+}
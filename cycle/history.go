@@ -0,0 +1,65 @@
+package cycle
+
+// History records the states of a simulation as it runs, by a key that
+// identifies each state, so the caller notices the first repeat. It suits
+// simulations whose states are large or not comparable, and loops the
+// caller drives itself.
+type History[K comparable] struct {
+	seen map[K]int
+}
+
+// NewHistory returns an empty history.
+func NewHistory[K comparable]() *History[K] {
+	return &History[K]{seen: make(map[K]int)}
+}
+
+// Add records the key of the next step. If an earlier step had the same
+// key it records nothing and returns the cycle instead.
+func (h *History[K]) Add(key K) (Cycle, bool) {
+	if step, ok := h.seen[key]; ok {
+		return Cycle{step, len(h.seen) - step}, true
+	}
+	h.seen[key] = len(h.seen)
+	return Cycle{}, false
+}
+
+// Len returns the number of steps recorded.
+func (h *History[K]) Len() int {
+	return len(h.seen)
+}
+
+// Step returns the step that had key.
+func (h *History[K]) Step(key K) (int, bool) {
+	step, ok := h.seen[key]
+	return step, ok
+}
+
+// Find runs f from x0 until a state's key repeats. It returns the cycle and
+// the states up to the repeat, so states[c.Index(n)] is the state at step
+// n. Each state must be a fresh value: f must not change its argument.
+func Find[S any, K comparable](x0 S, f func(S) S, key func(S) K) (Cycle, []S) {
+	h := NewHistory[K]()
+	var states []S
+	for x := x0; ; x = f(x) {
+		if c, ok := h.Add(key(x)); ok {
+			return c, states
+		}
+		states = append(states, x)
+	}
+}
+
+// NthFunc returns the state at step n, stopping early at step n if no key
+// has repeated by then.
+func NthFunc[S any, K comparable](x0 S, f func(S) S, key func(S) K, n int) S {
+	h := NewHistory[K]()
+	var states []S
+	x := x0
+	for step := 0; step < n; step++ {
+		if c, ok := h.Add(key(x)); ok {
+			return states[c.Index(n)]
+		}
+		states = append(states, x)
+		x = f(x)
+	}
+	return x
+}
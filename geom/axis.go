@@ -0,0 +1,47 @@
+package geom
+
+import (
+	"slices"
+	"sort"
+)
+
+// Axis compresses one coordinate. The cuts split the line into cells
+// [cut[i], cut[i+1]); a shape whose edges all fall on cuts is uniform across
+// each cell, so a plane of a few hundred cells can stand in for billions of
+// tiles.
+type Axis struct {
+	cuts []int64
+}
+
+// NewAxis returns the axis cut at each of the values, in any order and with
+// repeats.
+func NewAxis(cuts ...int64) *Axis {
+	cuts = slices.Clone(cuts)
+	slices.Sort(cuts)
+	return &Axis{slices.Compact(cuts)}
+}
+
+// Len returns the number of cells, one fewer than the number of cuts.
+func (a *Axis) Len() int {
+	return max(len(a.cuts)-1, 0)
+}
+
+// Cell returns the index of the cell holding v, or -1 if v is before the
+// first cut or at or after the last.
+func (a *Axis) Cell(v int64) int {
+	i := sort.Search(len(a.cuts), func(i int) bool { return a.cuts[i] > v }) - 1
+	if i >= a.Len() {
+		return -1
+	}
+	return i
+}
+
+// Start returns the first value in cell i.
+func (a *Axis) Start(i int) int64 {
+	return a.cuts[i]
+}
+
+// Width returns the number of values in cell i.
+func (a *Axis) Width(i int) int64 {
+	return a.cuts[i+1] - a.cuts[i]
+}
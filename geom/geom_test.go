@@ -0,0 +1,154 @@
+package geom
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// polygons are drawn as "x,y x,y ..." vertex lists.
+var polygons = map[string]string{
+	"square":  "0,0 3,0 3,3 0,3",
+	"example": "7,1 11,1 11,7 9,7 9,5 2,5 2,3 7,3",
+	"U":       "0,0 8,0 8,6 6,6 6,2 2,2 2,6 0,6",
+	// The notch touches the opposite wall, leaving a one-tile bridge.
+	"touching": "0,0 6,0 6,4 4,4 4,1 2,1 2,4 0,4",
+	"spiral":   "0,0 10,0 10,10 2,10 2,4 6,4 6,6 4,6 4,8 8,8 8,2 0,2",
+}
+
+func mustPolygon(t *testing.T, spec string) *Polygon {
+	t.Helper()
+	var vs []Point
+	for _, f := range strings.Fields(spec) {
+		var x, y int64
+		if _, err := fmt.Sscanf(f, "%d,%d", &x, &y); err != nil {
+			t.Fatal(err)
+		}
+		vs = append(vs, Point{x, y})
+	}
+	p, err := NewPolygon(vs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// fill marks the tiles of p the slow way: draw the loop on a padded bitmap
+// and flood the outside from a corner.
+func fill(p *Polygon) (in map[Point]bool, bounds Rect) {
+	b := p.Bounds()
+	bounds = Rect{Point{b.Min.X - 1, b.Min.Y - 1}, Point{b.Max.X + 1, b.Max.Y + 1}}
+	wall := make(map[Point]bool)
+	p.Edges(func(a, b Point) {
+		r := RectFrom(a, b)
+		for x := r.Min.X; x <= r.Max.X; x++ {
+			for y := r.Min.Y; y <= r.Max.Y; y++ {
+				wall[Point{x, y}] = true
+			}
+		}
+	})
+	out := map[Point]bool{bounds.Min: true}
+	stack := []Point{bounds.Min}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, d := range []Point{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			n := Point{cur.X + d.X, cur.Y + d.Y}
+			if n.X < bounds.Min.X || n.X > bounds.Max.X || n.Y < bounds.Min.Y || n.Y > bounds.Max.Y || wall[n] || out[n] {
+				continue
+			}
+			out[n] = true
+			stack = append(stack, n)
+		}
+	}
+	in = make(map[Point]bool)
+	for x := bounds.Min.X; x <= bounds.Max.X; x++ {
+		for y := bounds.Min.Y; y <= bounds.Max.Y; y++ {
+			if !out[Point{x, y}] {
+				in[Point{x, y}] = true
+			}
+		}
+	}
+	return in, bounds
+}
+
+func TestRegion(t *testing.T) {
+	for name, spec := range polygons {
+		p := mustPolygon(t, spec)
+		reg := NewRegion(p)
+		in, bounds := fill(p)
+		if got, want := p.Tiles(), int64(len(in)); got != want {
+			t.Errorf("%s: Polygon.Tiles = %d, want %d", name, got, want)
+		}
+		if got, want := reg.Tiles(), int64(len(in)); got != want {
+			t.Errorf("%s: Region.Tiles = %d, want %d", name, got, want)
+		}
+		var tiles []Point
+		for x := bounds.Min.X - 1; x <= bounds.Max.X+1; x++ {
+			for y := bounds.Min.Y - 1; y <= bounds.Max.Y+1; y++ {
+				tiles = append(tiles, Point{x, y})
+			}
+		}
+		for _, a := range tiles {
+			if got := reg.Contains(a); got != in[a] {
+				t.Errorf("%s: Contains(%v) = %t", name, a, got)
+			}
+			for _, b := range tiles {
+				r := RectFrom(a, b)
+				want := true
+				for x := r.Min.X; x <= r.Max.X && want; x++ {
+					for y := r.Min.Y; y <= r.Max.Y && want; y++ {
+						want = in[Point{x, y}]
+					}
+				}
+				if got := reg.ContainsRect(r); got != want {
+					t.Fatalf("%s: ContainsRect(%v-%v) = %t", name, r.Min, r.Max, got)
+				}
+			}
+		}
+	}
+}
+
+func TestLarge(t *testing.T) {
+	// A plus sign with arms a hundred million tiles long.
+	const a, b = 100_000_000, 300_000_000
+	p := mustPolygon(t, "100000000,0 200000000,0 200000000,100000000 300000000,100000000 300000000,200000000 200000000,200000000 200000000,300000000 100000000,300000000 100000000,200000000 0,200000000 0,100000000 100000000,100000000")
+	reg := NewRegion(p)
+	if got, want := reg.Tiles(), p.Tiles(); got != want {
+		t.Errorf("Region.Tiles = %d, Polygon.Tiles = %d", got, want)
+	}
+	if !reg.ContainsRect(RectFrom(Point{0, a}, Point{b, 2 * a})) {
+		t.Error("the horizontal bar is not inside")
+	}
+	if reg.ContainsRect(RectFrom(Point{0, a}, Point{b, 2*a + 1})) {
+		t.Error("a bar one tile too tall is inside")
+	}
+	if reg.Contains(Point{a - 1, a - 1}) || !reg.Contains(Point{a, a}) {
+		t.Error("the corner of the cross is wrong")
+	}
+}
+
+func TestNewPolygon(t *testing.T) {
+	for _, spec := range [][]Point{
+		{{0, 0}, {2, 0}, {2, 2}},
+		{{0, 0}, {2, 0}, {2, 2}, {1, 3}},
+		{{0, 0}, {2, 0}, {2, 0}, {0, 2}},
+	} {
+		if _, err := NewPolygon(spec); err == nil {
+			t.Errorf("NewPolygon(%v) succeeded", spec)
+		}
+	}
+}
+
+func TestPrefixSum(t *testing.T) {
+	p := NewPrefixSum(3, 4, func(r, c int) int64 { return int64(r*4 + c) })
+	if got := p.Sum(0, 0, 2, 3); got != 66 {
+		t.Errorf("total = %d, want 66", got)
+	}
+	if got := p.Sum(1, 1, 2, 2); got != 5+6+9+10 {
+		t.Errorf("middle = %d, want 30", got)
+	}
+	if got := p.Sum(2, 0, 1, 3); got != 0 {
+		t.Errorf("empty block = %d", got)
+	}
+}
@@ -0,0 +1,117 @@
+// Package geom handles axis-aligned shapes on the integer plane, such as the
+// tile loop of 2025 day 9.
+//
+// Coordinates name tiles, not the lines between them: the rectangle with
+// corners (2, 3) and (4, 3) covers the three tiles (2, 3), (3, 3) and
+// (4, 3). All arithmetic is exact int64.
+package geom
+
+import "fmt"
+
+// Point is a tile on the plane.
+type Point struct {
+	X, Y int64
+}
+
+func (p Point) String() string { return fmt.Sprintf("%d,%d", p.X, p.Y) }
+
+// Rect is the block of tiles from Min to Max inclusive.
+type Rect struct {
+	Min, Max Point
+}
+
+// RectFrom returns the rectangle with opposite corners a and b.
+func RectFrom(a, b Point) Rect {
+	return Rect{
+		Point{min(a.X, b.X), min(a.Y, b.Y)},
+		Point{max(a.X, b.X), max(a.Y, b.Y)},
+	}
+}
+
+// Tiles returns the number of tiles the rectangle covers.
+func (r Rect) Tiles() int64 {
+	return (r.Max.X - r.Min.X + 1) * (r.Max.Y - r.Min.Y + 1)
+}
+
+// Polygon is a closed rectilinear loop: each vertex shares a row or a column
+// with the next, and the last joins back to the first. The loop runs through
+// the centres of its tiles, and those tiles belong to the polygon.
+type Polygon struct {
+	vertices []Point
+}
+
+// NewPolygon checks that the vertices form a rectilinear loop. It does not
+// check that the loop is simple; a loop that crosses itself gives
+// meaningless areas.
+func NewPolygon(vertices []Point) (*Polygon, error) {
+	if len(vertices) < 4 {
+		return nil, fmt.Errorf("polygon has %d vertices, want at least 4", len(vertices))
+	}
+	for i, a := range vertices {
+		b := vertices[(i+1)%len(vertices)]
+		if (a.X == b.X) == (a.Y == b.Y) {
+			return nil, fmt.Errorf("polygon edge %v to %v is not horizontal or vertical", a, b)
+		}
+	}
+	return &Polygon{vertices}, nil
+}
+
+// Vertices returns the corners of the polygon in order.
+func (p *Polygon) Vertices() []Point {
+	return p.vertices
+}
+
+// Edges calls fn with the ends of each edge in turn.
+func (p *Polygon) Edges(fn func(a, b Point)) {
+	for i, a := range p.vertices {
+		fn(a, p.vertices[(i+1)%len(p.vertices)])
+	}
+}
+
+// Bounds returns the smallest rectangle holding the polygon.
+func (p *Polygon) Bounds() Rect {
+	r := Rect{p.vertices[0], p.vertices[0]}
+	for _, v := range p.vertices[1:] {
+		r.Min.X, r.Min.Y = min(r.Min.X, v.X), min(r.Min.Y, v.Y)
+		r.Max.X, r.Max.Y = max(r.Max.X, v.X), max(r.Max.Y, v.Y)
+	}
+	return r
+}
+
+// TwiceArea returns twice the area enclosed by the loop through the tile
+// centres, by the shoelace formula. It is positive when the vertices run
+// counter-clockwise with Y pointing up. The products are taken relative to
+// the first vertex, so only the polygon's size, not its position, must keep
+// them within int64.
+func (p *Polygon) TwiceArea() int64 {
+	var sum int64
+	o := p.vertices[0]
+	p.Edges(func(a, b Point) {
+		sum += (a.X-o.X)*(b.Y-o.Y) - (b.X-o.X)*(a.Y-o.Y)
+	})
+	return sum
+}
+
+// Perimeter returns the length of the loop, which is also the number of
+// tiles on it.
+func (p *Polygon) Perimeter() int64 {
+	var sum int64
+	p.Edges(func(a, b Point) {
+		sum += abs(b.X-a.X) + abs(b.Y-a.Y)
+	})
+	return sum
+}
+
+// Tiles returns the number of tiles on or inside the loop. By Pick's theorem
+// the area is interior + boundary/2 - 1, so the tiles number
+// area + boundary/2 + 1.
+func (p *Polygon) Tiles() int64 {
+	return (abs(p.TwiceArea())+p.Perimeter())/2 + 1
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,30 @@
+package geom
+
+// PrefixSum answers sums over rectangular blocks of a table in constant
+// time.
+type PrefixSum struct {
+	rows, cols int
+	sums       []int64
+}
+
+// NewPrefixSum sums the table whose cell (r, c) holds value(r, c).
+func NewPrefixSum(rows, cols int, value func(r, c int) int64) *PrefixSum {
+	p := &PrefixSum{rows, cols, make([]int64, (rows+1)*(cols+1))}
+	w := cols + 1
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			p.sums[(r+1)*w+c+1] = value(r, c) + p.sums[r*w+c+1] + p.sums[(r+1)*w+c] - p.sums[r*w+c]
+		}
+	}
+	return p
+}
+
+// Sum returns the total of rows r0 through r1 and columns c0 through c1,
+// inclusive. An empty block sums to 0.
+func (p *PrefixSum) Sum(r0, c0, r1, c1 int) int64 {
+	if r0 > r1 || c0 > c1 {
+		return 0
+	}
+	w := p.cols + 1
+	return p.sums[(r1+1)*w+c1+1] - p.sums[r0*w+c1+1] - p.sums[(r1+1)*w+c0] + p.sums[r0*w+c0]
+}
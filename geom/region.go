@@ -0,0 +1,87 @@
+package geom
+
+import "github.com/shubhamsugara22/AdventOfCode-202X/grid"
+
+// Region is the set of tiles on or inside a polygon, compressed so that
+// questions about it take time independent of the coordinates' size.
+type Region struct {
+	xs, ys *Axis
+
+	// outside counts, over blocks of compressed cells, the cells that lie
+	// outside the polygon.
+	outside *PrefixSum
+	tiles   int64
+}
+
+// NewRegion fills the polygon. Both axes are cut at every vertex coordinate
+// and one past it, so each vertex row and column is a cell of its own, and
+// one tile beyond the bounds on each side, so the outside forms a ring that
+// a flood fill can start from.
+func NewRegion(p *Polygon) *Region {
+	var xs, ys []int64
+	for _, v := range p.vertices {
+		xs = append(xs, v.X, v.X+1)
+		ys = append(ys, v.Y, v.Y+1)
+	}
+	b := p.Bounds()
+	xs = append(xs, b.Min.X-1, b.Max.X+2)
+	ys = append(ys, b.Min.Y-1, b.Max.Y+2)
+	reg := &Region{xs: NewAxis(xs...), ys: NewAxis(ys...)}
+
+	// Rows follow Y and columns follow X.
+	cells := grid.New[byte](reg.ys.Len(), reg.xs.Len())
+	const (
+		unknown = iota
+		edge
+		outside
+	)
+	p.Edges(func(a, b Point) {
+		r := RectFrom(a, b)
+		for row := reg.ys.Cell(r.Min.Y); row <= reg.ys.Cell(r.Max.Y); row++ {
+			for col := reg.xs.Cell(r.Min.X); col <= reg.xs.Cell(r.Max.X); col++ {
+				cells.Set(grid.Point{R: row, C: col}, edge)
+			}
+		}
+	})
+	stack := []grid.Point{{R: 0, C: 0}}
+	cells.Set(stack[0], outside)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, n := range cells.Neighbors4(cur) {
+			if cells.At(n) == unknown {
+				cells.Set(n, outside)
+				stack = append(stack, n)
+			}
+		}
+	}
+
+	reg.outside = NewPrefixSum(cells.Rows(), cells.Cols(), func(r, c int) int64 {
+		if cells.At(grid.Point{R: r, C: c}) == outside {
+			return 1
+		}
+		reg.tiles += reg.ys.Width(r) * reg.xs.Width(c)
+		return 0
+	})
+	return reg
+}
+
+// Tiles returns the number of tiles in the region.
+func (reg *Region) Tiles() int64 {
+	return reg.tiles
+}
+
+// Contains reports whether the tile p is in the region.
+func (reg *Region) Contains(p Point) bool {
+	return reg.ContainsRect(Rect{p, p})
+}
+
+// ContainsRect reports whether every tile of r is in the region.
+func (reg *Region) ContainsRect(r Rect) bool {
+	c0, c1 := reg.xs.Cell(r.Min.X), reg.xs.Cell(r.Max.X)
+	r0, r1 := reg.ys.Cell(r.Min.Y), reg.ys.Cell(r.Max.Y)
+	if c0 < 0 || c1 < 0 || r0 < 0 || r1 < 0 {
+		return false
+	}
+	return reg.outside.Sum(r0, c0, r1, c1) == 0
+}
@@ -0,0 +1,112 @@
+package gf2
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestVec(t *testing.T) {
+	v, err := ParseVec(".##.#")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.String() != "01101" || v.Weight() != 3 {
+		t.Errorf("ParseVec = %v, weight %d", v, v.Weight())
+	}
+	u := NewVec(130)
+	u.Set(0, true)
+	u.Set(64, true)
+	u.Set(129, true)
+	if got := u.Ones(); len(got) != 3 || got[1] != 64 || got[2] != 129 {
+		t.Errorf("Ones = %v", got)
+	}
+	if _, err := ParseVec("01x"); err == nil {
+		t.Error("ParseVec accepted x")
+	}
+}
+
+func TestSystem(t *testing.T) {
+	// x0 + x1 = 1, x1 + x2 = 1, x0 + x2 = 0: rank 2, one free variable.
+	a := NewMatrix(3, 3)
+	for _, e := range [][2]int{{0, 0}, {0, 1}, {1, 1}, {1, 2}, {2, 0}, {2, 2}} {
+		a.Set(e[0], e[1], true)
+	}
+	b, _ := ParseVec("110")
+	s := NewSystem(a, b)
+	if s.Rank() != 2 || a.Rank() != 2 || len(s.Free()) != 1 {
+		t.Fatalf("rank %d, free %v", s.Rank(), s.Free())
+	}
+	x, ok := s.Solution()
+	if !ok || !a.MulVec(x).Equal(b) {
+		t.Errorf("Solution = %v, %v", x, ok)
+	}
+	for _, n := range s.NullSpace() {
+		if !a.MulVec(n).IsZero() {
+			t.Errorf("null space vector %v is not in the null space", n)
+		}
+	}
+	if _, ok := Solve(a, mustVec(t, "100")); ok {
+		t.Error("inconsistent system has a solution")
+	}
+}
+
+func mustVec(t *testing.T, s string) Vec {
+	t.Helper()
+	v, err := ParseVec(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// TestMinWeight checks MinWeight against trying every x on small random
+// systems.
+func TestMinWeight(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(10)
+		a := NewMatrix(rows, cols)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				a.Set(i, j, rng.Intn(3) == 0)
+			}
+		}
+		b := NewVec(rows)
+		for i := 0; i < rows; i++ {
+			b.Set(i, rng.Intn(2) == 0)
+		}
+
+		want := -1
+		for mask := 0; mask < 1<<cols; mask++ {
+			x := NewVec(cols)
+			x.w[0] = uint64(mask)
+			if a.MulVec(x).Equal(b) && (want < 0 || x.Weight() < want) {
+				want = x.Weight()
+			}
+		}
+
+		x, ok := MinWeight(a, b)
+		switch {
+		case ok != (want >= 0):
+			t.Fatalf("trial %d: MinWeight ok = %v, want %v\n%v", trial, ok, want >= 0, a)
+		case ok && (!a.MulVec(x).Equal(b) || x.Weight() != want):
+			t.Fatalf("trial %d: MinWeight = %v (weight %d), want weight %d", trial, x, x.Weight(), want)
+		}
+	}
+}
+
+func TestWide(t *testing.T) {
+	// One equation over 100 variables: x3 + x70 + x99 = 1.
+	a := NewMatrix(1, 100)
+	a.Set(0, 3, true)
+	a.Set(0, 70, true)
+	a.Set(0, 99, true)
+	b := mustVec(t, "1")
+	if got := len(NullSpace(a)); got != 99 {
+		t.Errorf("null space has %d vectors, want 99", got)
+	}
+	x, ok := MinWeight(a, b)
+	if !ok || x.Weight() != 1 || !a.MulVec(x).Equal(b) {
+		t.Errorf("MinWeight = %v, %v", x.Ones(), ok)
+	}
+}
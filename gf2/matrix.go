@@ -0,0 +1,103 @@
+package gf2
+
+import "strings"
+
+// Matrix is a matrix of bits stored as packed rows.
+type Matrix struct {
+	cols int
+	rows []Vec
+}
+
+// NewMatrix returns the zero rows×cols matrix.
+func NewMatrix(rows, cols int) *Matrix {
+	m := &Matrix{cols: cols, rows: make([]Vec, rows)}
+	for i := range m.rows {
+		m.rows[i] = NewVec(cols)
+	}
+	return m
+}
+
+// Rows returns the number of rows.
+func (m *Matrix) Rows() int { return len(m.rows) }
+
+// Cols returns the number of columns.
+func (m *Matrix) Cols() int { return m.cols }
+
+// Get returns the bit at row i, column j.
+func (m *Matrix) Get(i, j int) bool { return m.rows[i].Get(j) }
+
+// Set sets the bit at row i, column j to b.
+func (m *Matrix) Set(i, j int, b bool) { m.rows[i].Set(j, b) }
+
+// Flip flips the bit at row i, column j.
+func (m *Matrix) Flip(i, j int) { m.rows[i].Flip(j) }
+
+// Row returns row i. It shares storage with the matrix.
+func (m *Matrix) Row(i int) Vec { return m.rows[i] }
+
+// Clone returns a copy of m.
+func (m *Matrix) Clone() *Matrix {
+	c := &Matrix{cols: m.cols, rows: make([]Vec, len(m.rows))}
+	for i, r := range m.rows {
+		c.rows[i] = r.Clone()
+	}
+	return c
+}
+
+// MulVec returns m·x.
+func (m *Matrix) MulVec(x Vec) Vec {
+	y := NewVec(len(m.rows))
+	for i, r := range m.rows {
+		if r.Dot(x) {
+			y.Set(i, true)
+		}
+	}
+	return y
+}
+
+// Reduce brings m to reduced row echelon form in place and returns the
+// pivot column of each leading row; the rows after them are zero.
+func (m *Matrix) Reduce() []int {
+	return m.reduce(m.cols)
+}
+
+// reduce is Reduce with pivots taken only from the columns before limit.
+func (m *Matrix) reduce(limit int) []int {
+	var pivots []int
+	for col := 0; col < limit && len(pivots) < len(m.rows); col++ {
+		r := len(pivots)
+		sel := -1
+		for i := r; i < len(m.rows); i++ {
+			if m.rows[i].Get(col) {
+				sel = i
+				break
+			}
+		}
+		if sel < 0 {
+			continue
+		}
+		m.rows[r], m.rows[sel] = m.rows[sel], m.rows[r]
+		for i := range m.rows {
+			if i != r && m.rows[i].Get(col) {
+				m.rows[i].Xor(m.rows[r])
+			}
+		}
+		pivots = append(pivots, col)
+	}
+	return pivots
+}
+
+// Rank returns the rank of m.
+func (m *Matrix) Rank() int {
+	return len(m.Clone().Reduce())
+}
+
+// String returns the rows of m, one line each.
+func (m *Matrix) String() string {
+	var b strings.Builder
+	for _, r := range m.rows {
+		b.WriteString(r.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,129 @@
+package gf2
+
+// System is the reduced form of a linear system a·x = b.
+type System struct {
+	cols   int
+	aug    *Matrix // [a | b] in reduced row echelon form
+	pivots []int
+	free   []int
+}
+
+// NewSystem reduces the system a·x = b, where b has one bit per row of a.
+// a and b are not modified.
+func NewSystem(a *Matrix, b Vec) *System {
+	aug := NewMatrix(a.Rows(), a.Cols()+1)
+	for i := range aug.rows {
+		copy(aug.rows[i].w, a.rows[i].w)
+		aug.rows[i].Set(a.Cols(), b.Get(i))
+	}
+	s := &System{cols: a.Cols(), aug: aug, pivots: aug.reduce(a.Cols())}
+	next := 0
+	for col := 0; col < a.Cols(); col++ {
+		if next < len(s.pivots) && s.pivots[next] == col {
+			next++
+			continue
+		}
+		s.free = append(s.free, col)
+	}
+	return s
+}
+
+// Rank returns the rank of a.
+func (s *System) Rank() int {
+	return len(s.pivots)
+}
+
+// Free returns the free columns, whose variables can be chosen freely.
+func (s *System) Free() []int {
+	return s.free
+}
+
+// Solvable reports whether the system has a solution.
+func (s *System) Solvable() bool {
+	for i := len(s.pivots); i < s.aug.Rows(); i++ {
+		if s.aug.Get(i, s.cols) {
+			return false
+		}
+	}
+	return true
+}
+
+// Solution returns the solution with every free variable zero, and false if
+// there is none.
+func (s *System) Solution() (Vec, bool) {
+	if !s.Solvable() {
+		return Vec{}, false
+	}
+	x := NewVec(s.cols)
+	for i, col := range s.pivots {
+		x.Set(col, s.aug.Get(i, s.cols))
+	}
+	return x, true
+}
+
+// NullSpace returns a basis of the solutions of a·x = 0, one vector per
+// free variable: vector k sets free variable k and no other.
+func (s *System) NullSpace() []Vec {
+	basis := make([]Vec, len(s.free))
+	for k, f := range s.free {
+		v := NewVec(s.cols)
+		v.Set(f, true)
+		for i, col := range s.pivots {
+			if s.aug.Get(i, f) {
+				v.Set(col, true)
+			}
+		}
+		basis[k] = v
+	}
+	return basis
+}
+
+// MinWeight returns a solution with the fewest set bits, and false if there
+// is no solution.
+//
+// Every solution is the particular one plus a sum of null space vectors,
+// and a sum of c of them sets c free variables, so it weighs at least c.
+// The search adds basis vectors depth first and stops going deeper once c
+// reaches the best weight found. It visits the sums of fewer than that
+// many vectors rather than all 2^k of them; with a light solution and
+// many free variables that is far fewer.
+func (s *System) MinWeight() (Vec, bool) {
+	x, ok := s.Solution()
+	if !ok {
+		return Vec{}, false
+	}
+	basis := s.NullSpace()
+	best, bestW := x.Clone(), x.Weight()
+	var search func(next, chosen int)
+	search = func(next, chosen int) {
+		if w := x.Weight(); w < bestW {
+			best, bestW = x.Clone(), w
+		}
+		if chosen+1 >= bestW {
+			return
+		}
+		for k := next; k < len(basis); k++ {
+			x.Xor(basis[k])
+			search(k+1, chosen+1)
+			x.Xor(basis[k])
+		}
+	}
+	search(0, 0)
+	return best, true
+}
+
+// Solve returns a solution of a·x = b, and false if there is none.
+func Solve(a *Matrix, b Vec) (Vec, bool) {
+	return NewSystem(a, b).Solution()
+}
+
+// NullSpace returns a basis of the solutions of a·x = 0.
+func NullSpace(a *Matrix) []Vec {
+	return NewSystem(a, NewVec(a.Rows())).NullSpace()
+}
+
+// MinWeight returns a solution of a·x = b with the fewest set bits, and
+// false if there is none.
+func MinWeight(a *Matrix, b Vec) (Vec, bool) {
+	return NewSystem(a, b).MinWeight()
+}
@@ -0,0 +1,138 @@
+// Package gf2 does linear algebra over GF(2), the field of bits where
+// addition is XOR. Vectors and matrix rows are packed 64 bits to a word, so
+// a row operation on a 64-column matrix is a single XOR.
+package gf2
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Vec is a vector of bits. Vectors of different lengths must not be mixed.
+type Vec struct {
+	n int
+	w []uint64
+}
+
+// NewVec returns the zero vector of length n.
+func NewVec(n int) Vec {
+	return Vec{n, make([]uint64, (n+63)/64)}
+}
+
+// ParseVec reads a vector from a string of '0'/'1' or '.'/'#' characters.
+func ParseVec(s string) (Vec, error) {
+	v := NewVec(len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '1', '#':
+			v.Set(i, true)
+		case '0', '.':
+		default:
+			return Vec{}, fmt.Errorf("invalid bit %q at %d", s[i], i)
+		}
+	}
+	return v, nil
+}
+
+// Len returns the length of the vector.
+func (v Vec) Len() int {
+	return v.n
+}
+
+// Get returns bit i.
+func (v Vec) Get(i int) bool {
+	return v.w[i/64]>>(i%64)&1 == 1
+}
+
+// Set sets bit i to b.
+func (v Vec) Set(i int, b bool) {
+	if b {
+		v.w[i/64] |= 1 << (i % 64)
+	} else {
+		v.w[i/64] &^= 1 << (i % 64)
+	}
+}
+
+// Flip flips bit i.
+func (v Vec) Flip(i int) {
+	v.w[i/64] ^= 1 << (i % 64)
+}
+
+// Xor adds u to v in place.
+func (v Vec) Xor(u Vec) {
+	for i, w := range u.w {
+		v.w[i] ^= w
+	}
+}
+
+// Weight returns the number of set bits.
+func (v Vec) Weight() int {
+	n := 0
+	for _, w := range v.w {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// IsZero reports whether no bit is set.
+func (v Vec) IsZero() bool {
+	for _, w := range v.w {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Ones returns the indexes of the set bits in increasing order.
+func (v Vec) Ones() []int {
+	var ones []int
+	for i, w := range v.w {
+		for w != 0 {
+			ones = append(ones, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+	return ones
+}
+
+// Dot returns the inner product of v and u.
+func (v Vec) Dot(u Vec) bool {
+	n := 0
+	for i, w := range v.w {
+		n += bits.OnesCount64(w & u.w[i])
+	}
+	return n%2 == 1
+}
+
+// Equal reports whether v and u hold the same bits.
+func (v Vec) Equal(u Vec) bool {
+	if v.n != u.n {
+		return false
+	}
+	for i, w := range v.w {
+		if w != u.w[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of v.
+func (v Vec) Clone() Vec {
+	return Vec{v.n, append([]uint64(nil), v.w...)}
+}
+
+// String returns the bits as '0' and '1' characters, bit 0 first.
+func (v Vec) String() string {
+	var b strings.Builder
+	for i := 0; i < v.n; i++ {
+		if v.Get(i) {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
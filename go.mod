@@ -0,0 +1,3 @@
+module github.com/shubhamsugara22/AdventOfCode-202X
+
+go 1.22
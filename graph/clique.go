@@ -0,0 +1,98 @@
+package graph
+
+// Cliques treat the graph as undirected: a and b are adjacent if the graph
+// has the edge a -> b. Graphs built with AddUndirected satisfy this. A node
+// is never adjacent to itself, so self-loops are ignored.
+
+// MaximalCliques returns every clique that cannot be extended by another
+// node, each sorted.
+func (g *Graph[K]) MaximalCliques() [][]K {
+	var cliques [][]K
+	g.bronKerbosch(nil, sorted(g.nodes), nil, nil, func(c []K) {
+		cliques = append(cliques, sorted(c))
+	})
+	return cliques
+}
+
+// MaxClique returns a largest clique, sorted. Of several cliques of that
+// size it returns the first found.
+func (g *Graph[K]) MaxClique() []K {
+	var best []K
+	prune := func(r, p int) bool { return r+p <= len(best) }
+	g.bronKerbosch(nil, sorted(g.nodes), nil, prune, func(c []K) {
+		if len(c) > len(best) {
+			best = sorted(c)
+		}
+	})
+	return best
+}
+
+// bronKerbosch reports the maximal cliques that contain all of r, some of p
+// and none of x. It branches only on the nodes of p not adjacent to a pivot
+// chosen to cover as much of p as possible, since every maximal clique
+// contains the pivot or one of its non-neighbours. prune, if set, abandons a
+// branch given the sizes of r and p.
+func (g *Graph[K]) bronKerbosch(r, p, x []K, prune func(r, p int) bool, report func([]K)) {
+	if len(p) == 0 {
+		if len(x) == 0 {
+			report(r)
+		}
+		return
+	}
+	if prune != nil && prune(len(r), len(p)) {
+		return
+	}
+
+	pivot, cover := p[0], -1
+	for _, set := range [][]K{p, x} {
+		for _, u := range set {
+			n := 0
+			for _, v := range p {
+				if g.linked(u, v) {
+					n++
+				}
+			}
+			if n > cover {
+				pivot, cover = u, n
+			}
+		}
+	}
+
+	var branch []K
+	for _, v := range p {
+		if !g.linked(pivot, v) {
+			branch = append(branch, v)
+		}
+	}
+	for _, v := range branch {
+		g.bronKerbosch(append(r[:len(r):len(r)], v), g.adjacent(p, v), g.adjacent(x, v), prune, report)
+		p = remove(p, v)
+		x = append(x[:len(x):len(x)], v)
+	}
+}
+
+// adjacent returns the nodes of set adjacent to v.
+func (g *Graph[K]) adjacent(set []K, v K) []K {
+	var out []K
+	for _, u := range set {
+		if g.linked(v, u) {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// linked reports whether distinct nodes a and b are adjacent.
+func (g *Graph[K]) linked(a, b K) bool {
+	return a != b && g.HasEdge(a, b)
+}
+
+func remove[K comparable](set []K, v K) []K {
+	out := make([]K, 0, len(set))
+	for _, u := range set {
+		if u != v {
+			out = append(out, u)
+		}
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CycleError reports a cycle in a graph that must be acyclic.
+type CycleError[K cmp.Ordered] struct {
+	// Cycle lists the nodes of the cycle in edge order; the last node has
+	// an edge back to the first.
+	Cycle []K
+}
+
+func (e *CycleError[K]) Error() string {
+	var b strings.Builder
+	b.WriteString("graph has a cycle: ")
+	for _, k := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", k)
+	}
+	fmt.Fprintf(&b, "%v", e.Cycle[0])
+	return b.String()
+}
+
+// TopoSort returns the nodes ordered so that every edge points forward.
+// Among nodes whose order is not forced, those added first come first. If
+// the graph has a cycle, TopoSort returns a *CycleError naming one.
+func (g *Graph[K]) TopoSort() ([]K, error) {
+	indeg := make(map[K]int, len(g.nodes))
+	for _, k := range g.nodes {
+		for _, to := range g.out[k] {
+			indeg[to]++
+		}
+	}
+	var queue []K
+	for _, k := range g.nodes {
+		if indeg[k] == 0 {
+			queue = append(queue, k)
+		}
+	}
+	order := make([]K, 0, len(g.nodes))
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		order = append(order, k)
+		for _, to := range g.out[k] {
+			indeg[to]--
+			if indeg[to] == 0 {
+				queue = append(queue, to)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return order, &CycleError[K]{g.findCycle(indeg)}
+	}
+	return order, nil
+}
+
+// findCycle returns a cycle among the nodes Kahn's algorithm could not
+// order, those left with a positive in-degree. Each of them has a
+// predecessor among the others, so walking predecessors must come back to a
+// node already seen.
+func (g *Graph[K]) findCycle(indeg map[K]int) []K {
+	pred := make(map[K]K)
+	var start K
+	found := false
+	for _, k := range g.nodes {
+		if indeg[k] == 0 {
+			continue
+		}
+		if !found {
+			start, found = k, true
+		}
+		for _, to := range g.out[k] {
+			if _, ok := pred[to]; !ok && indeg[to] > 0 {
+				pred[to] = k
+			}
+		}
+	}
+	at := make(map[K]int)
+	var walk []K
+	k := start
+	for {
+		if i, ok := at[k]; ok {
+			walk = walk[i:]
+			break
+		}
+		at[k] = len(walk)
+		walk = append(walk, k)
+		k = pred[k]
+	}
+	// The walk follows edges backwards. Turn it around and start it at the
+	// node added first.
+	slices.Reverse(walk)
+	first := 0
+	for _, k := range g.nodes {
+		if i := slices.Index(walk, k); i >= 0 {
+			first = i
+			break
+		}
+	}
+	return append(walk[first:], walk[:first]...)
+}
+
+// CountPaths returns the number of distinct paths from one node to another.
+// Only the part of the graph between them matters: if a cycle lies on some
+// path from one to the other there are infinitely many paths and CountPaths
+// returns a *CycleError, but cycles elsewhere are fine. A node has one path
+// to itself.
+func (g *Graph[K]) CountPaths(from, to K) (int, error) {
+	if !g.Has(from) || !g.Has(to) {
+		return 0, nil
+	}
+	ahead := g.Reachable(from)
+	behind := g.Reverse().Reachable(to)
+	between := g.Subgraph(func(k K) bool { return ahead[k] && behind[k] })
+	order, err := between.TopoSort()
+	if err != nil {
+		return 0, err
+	}
+	paths := make(map[K]int, len(order))
+	paths[from] = 1
+	for _, k := range order {
+		for _, next := range between.out[k] {
+			paths[next] += paths[k]
+		}
+	}
+	return paths[to], nil
+}
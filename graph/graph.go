@@ -0,0 +1,166 @@
+// Package graph holds graph algorithms over adjacency lists keyed by
+// strings, ints or any other ordered type. Results are deterministic: nodes
+// are visited in the order they were added.
+package graph
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Graph is a directed graph. An undirected graph stores every edge both
+// ways; see AddUndirected.
+type Graph[K cmp.Ordered] struct {
+	nodes []K
+	out   map[K][]K
+	edge  map[[2]K]bool
+}
+
+// New returns an empty graph.
+func New[K cmp.Ordered]() *Graph[K] {
+	return &Graph[K]{out: make(map[K][]K), edge: make(map[[2]K]bool)}
+}
+
+// AddNode adds k if it is not already in the graph.
+func (g *Graph[K]) AddNode(k K) {
+	if _, ok := g.out[k]; !ok {
+		g.nodes = append(g.nodes, k)
+		g.out[k] = nil
+	}
+}
+
+// AddEdge adds the edge from -> to and both nodes. Adding an edge twice has
+// no effect.
+func (g *Graph[K]) AddEdge(from, to K) {
+	g.AddNode(from)
+	g.AddNode(to)
+	if !g.edge[[2]K{from, to}] {
+		g.edge[[2]K{from, to}] = true
+		g.out[from] = append(g.out[from], to)
+	}
+}
+
+// AddUndirected adds the edges a -> b and b -> a.
+func (g *Graph[K]) AddUndirected(a, b K) {
+	g.AddEdge(a, b)
+	g.AddEdge(b, a)
+}
+
+// Has reports whether k is in the graph.
+func (g *Graph[K]) Has(k K) bool {
+	_, ok := g.out[k]
+	return ok
+}
+
+// HasEdge reports whether the graph has the edge from -> to.
+func (g *Graph[K]) HasEdge(from, to K) bool {
+	return g.edge[[2]K{from, to}]
+}
+
+// Nodes returns the nodes in the order they were added. The slice must not
+// be modified.
+func (g *Graph[K]) Nodes() []K {
+	return g.nodes
+}
+
+// Neighbors returns the targets of the edges out of k in the order they were
+// added. The slice must not be modified.
+func (g *Graph[K]) Neighbors(k K) []K {
+	return g.out[k]
+}
+
+// Len returns the number of nodes.
+func (g *Graph[K]) Len() int {
+	return len(g.nodes)
+}
+
+// Subgraph returns the graph induced by the nodes for which keep is true.
+func (g *Graph[K]) Subgraph(keep func(K) bool) *Graph[K] {
+	sub := New[K]()
+	for _, k := range g.nodes {
+		if keep(k) {
+			sub.AddNode(k)
+		}
+	}
+	for _, k := range sub.nodes {
+		for _, to := range g.out[k] {
+			if sub.Has(to) {
+				sub.AddEdge(k, to)
+			}
+		}
+	}
+	return sub
+}
+
+// Reachable returns the nodes reachable from the given ones, which are
+// included.
+func (g *Graph[K]) Reachable(from ...K) map[K]bool {
+	seen := make(map[K]bool)
+	stack := make([]K, 0, len(from))
+	for _, k := range from {
+		if g.Has(k) && !seen[k] {
+			seen[k] = true
+			stack = append(stack, k)
+		}
+	}
+	for len(stack) > 0 {
+		k := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, to := range g.out[k] {
+			if !seen[to] {
+				seen[to] = true
+				stack = append(stack, to)
+			}
+		}
+	}
+	return seen
+}
+
+// Reverse returns the graph with every edge turned around.
+func (g *Graph[K]) Reverse() *Graph[K] {
+	rev := New[K]()
+	for _, k := range g.nodes {
+		rev.AddNode(k)
+	}
+	for _, k := range g.nodes {
+		for _, to := range g.out[k] {
+			rev.AddEdge(to, k)
+		}
+	}
+	return rev
+}
+
+// Components returns the connected components of the graph, ignoring edge
+// directions. Components are ordered by their first node, and the nodes of
+// each component keep the order they were added in.
+func (g *Graph[K]) Components() [][]K {
+	index := make(map[K]int, len(g.nodes))
+	for i, k := range g.nodes {
+		index[k] = i
+	}
+	uf := NewUnionFind(len(g.nodes))
+	for i, k := range g.nodes {
+		for _, to := range g.out[k] {
+			uf.Union(i, index[to])
+		}
+	}
+	var comps [][]K
+	slot := make(map[int]int)
+	for i, k := range g.nodes {
+		root := uf.Find(i)
+		c, ok := slot[root]
+		if !ok {
+			c = len(comps)
+			slot[root] = c
+			comps = append(comps, nil)
+		}
+		comps[c] = append(comps[c], k)
+	}
+	return comps
+}
+
+func sorted[K cmp.Ordered](ks []K) []K {
+	ks = slices.Clone(ks)
+	slices.Sort(ks)
+	return ks
+}
@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTopoSort(t *testing.T) {
+	g := New[int]()
+	for _, e := range [][2]int{{75, 47}, {47, 61}, {75, 61}, {61, 53}, {29, 13}} {
+		g.AddEdge(e[0], e[1])
+	}
+	order, err := g.TopoSort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{75, 29, 47, 13, 61, 53}; !reflect.DeepEqual(order, want) {
+		t.Errorf("TopoSort = %v, want %v", order, want)
+	}
+
+	g.AddEdge(53, 47)
+	_, err = g.TopoSort()
+	var cycle *CycleError[int]
+	if !errors.As(err, &cycle) {
+		t.Fatalf("TopoSort with a cycle: err = %v", err)
+	}
+	if want := []int{47, 61, 53}; !reflect.DeepEqual(cycle.Cycle, want) {
+		t.Errorf("Cycle = %v, want %v", cycle.Cycle, want)
+	}
+	if got, want := err.Error(), "graph has a cycle: 47 -> 61 -> 53 -> 47"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestCountPaths(t *testing.T) {
+	g := New[string]()
+	for _, e := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}, {"d", "e"}, {"x", "y"}, {"y", "x"}} {
+		g.AddEdge(e[0], e[1])
+	}
+	g.AddEdge("e", "x") // a cycle past the target does not count
+	if n, err := g.CountPaths("a", "d"); err != nil || n != 2 {
+		t.Errorf("CountPaths(a, d) = %d, %v, want 2", n, err)
+	}
+	if n, err := g.CountPaths("d", "a"); err != nil || n != 0 {
+		t.Errorf("CountPaths(d, a) = %d, %v, want 0", n, err)
+	}
+	if _, err := g.CountPaths("a", "y"); err == nil {
+		t.Error("CountPaths through a cycle: no error")
+	}
+}
+
+func TestCliques(t *testing.T) {
+	g := New[string]()
+	for _, e := range [][2]string{
+		{"ka", "co"}, {"ka", "de"}, {"ka", "ta"}, {"co", "de"}, {"co", "ta"}, {"de", "ta"},
+		{"ta", "x"}, {"x", "y"}, {"z", "z2"},
+		// Self-loops do not make a node its own neighbour.
+		{"ta", "ta"}, {"z", "z"}, {"s", "s"},
+	} {
+		g.AddUndirected(e[0], e[1])
+	}
+	if got, want := g.MaxClique(), []string{"co", "de", "ka", "ta"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MaxClique = %v, want %v", got, want)
+	}
+	want := [][]string{{"co", "de", "ka", "ta"}, {"ta", "x"}, {"x", "y"}, {"z", "z2"}, {"s"}}
+	got := g.MaximalCliques()
+	if len(got) != len(want) {
+		t.Fatalf("MaximalCliques = %v, want %v", got, want)
+	}
+	for _, c := range want {
+		found := false
+		for _, d := range got {
+			found = found || reflect.DeepEqual(c, d)
+		}
+		if !found {
+			t.Errorf("MaximalCliques = %v, missing %v", got, c)
+		}
+	}
+}
+
+func TestComponents(t *testing.T) {
+	g := New[int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 4)
+	g.AddNode(6)
+	want := [][]int{{1, 2}, {3, 4, 5}, {6}}
+	if got := g.Components(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Components = %v, want %v", got, want)
+	}
+}
+
+func TestUnionFind(t *testing.T) {
+	u := NewUnionFind(6)
+	u.Union(0, 1)
+	u.Union(2, 3)
+	u.Union(1, 3)
+	if u.Union(0, 2) {
+		t.Error("Union of one set reports a merge")
+	}
+	if !u.Same(0, 3) || u.Same(0, 4) {
+		t.Error("Same is wrong")
+	}
+	if u.Sets() != 3 || u.Size(2) != 4 {
+		t.Errorf("Sets = %d, Size(2) = %d", u.Sets(), u.Size(2))
+	}
+	if got := u.Sizes(); !reflect.DeepEqual(got, []int{4, 1, 1}) {
+		t.Errorf("Sizes = %v", got)
+	}
+}
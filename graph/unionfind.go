@@ -0,0 +1,71 @@
+package graph
+
+import "slices"
+
+// UnionFind tracks a partition of the elements 0..n-1 into disjoint sets.
+type UnionFind struct {
+	parent, size []int
+	sets         int
+}
+
+// NewUnionFind returns n singleton sets.
+func NewUnionFind(n int) *UnionFind {
+	u := &UnionFind{parent: make([]int, n), size: make([]int, n), sets: n}
+	for i := range u.parent {
+		u.parent[i] = i
+		u.size[i] = 1
+	}
+	return u
+}
+
+// Find returns the representative of the set holding a.
+func (u *UnionFind) Find(a int) int {
+	for u.parent[a] != a {
+		u.parent[a] = u.parent[u.parent[a]]
+		a = u.parent[a]
+	}
+	return a
+}
+
+// Union merges the sets holding a and b and reports whether they were
+// different sets.
+func (u *UnionFind) Union(a, b int) bool {
+	ra, rb := u.Find(a), u.Find(b)
+	if ra == rb {
+		return false
+	}
+	if u.size[ra] < u.size[rb] {
+		ra, rb = rb, ra
+	}
+	u.parent[rb] = ra
+	u.size[ra] += u.size[rb]
+	u.sets--
+	return true
+}
+
+// Same reports whether a and b are in the same set.
+func (u *UnionFind) Same(a, b int) bool {
+	return u.Find(a) == u.Find(b)
+}
+
+// Size returns the size of the set holding a.
+func (u *UnionFind) Size(a int) int {
+	return u.size[u.Find(a)]
+}
+
+// Sets returns the number of disjoint sets.
+func (u *UnionFind) Sets() int {
+	return u.sets
+}
+
+// Sizes returns the size of every set, largest first.
+func (u *UnionFind) Sizes() []int {
+	sizes := make([]int, 0, u.sets)
+	for i, p := range u.parent {
+		if p == i {
+			sizes = append(sizes, u.size[i])
+		}
+	}
+	slices.SortFunc(sizes, func(a, b int) int { return b - a })
+	return sizes
+}
@@ -0,0 +1,253 @@
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrEmpty is returned when there are no rows to build a grid from.
+var ErrEmpty = errors.New("empty grid")
+
+// Grid is a rectangular grid of cells of type T.
+type Grid[T any] struct {
+	rows, cols int
+	cells      []T
+}
+
+// New returns a rows×cols grid of zero cells.
+func New[T any](rows, cols int) *Grid[T] {
+	return &Grid[T]{rows, cols, make([]T, rows*cols)}
+}
+
+// Filled returns a rows×cols grid with every cell set to v.
+func Filled[T any](rows, cols int, v T) *Grid[T] {
+	g := New[T](rows, cols)
+	for i := range g.cells {
+		g.cells[i] = v
+	}
+	return g
+}
+
+// bom is the UTF-8 byte order mark some editors put at the start of a file.
+const bom = "\ufeff"
+
+// Parse reads a grid of characters, one row per line. A byte order mark,
+// carriage returns and blank lines around the grid are ignored; rows of
+// different lengths are an error. Inputs with more sections than the grid
+// split their lines and use ParseLines.
+func Parse(r io.Reader) (*Grid[byte], error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	blank := false
+	for no := 1; scanner.Scan(); no++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if no == 1 {
+			line = strings.TrimPrefix(line, bom)
+		}
+		if line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			return nil, fmt.Errorf("line %d: text after the grid", no)
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ParseLines(lines)
+}
+
+// ParseLines builds a grid of characters from its rows. A byte order mark
+// before the first row is ignored.
+func ParseLines(lines []string) (*Grid[byte], error) {
+	return ParseFunc(lines, func(_ Point, ch byte) (byte, error) { return ch, nil })
+}
+
+// ParseFunc builds a grid from its rows, converting every character with fn.
+func ParseFunc[T any](lines []string, fn func(p Point, ch byte) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, ErrEmpty
+	}
+	g := New[T](len(lines), len(strings.TrimPrefix(lines[0], bom)))
+	for r, line := range lines {
+		if r == 0 {
+			line = strings.TrimPrefix(line, bom)
+		}
+		if len(line) != g.cols {
+			return nil, fmt.Errorf("row %d has %d cells, want %d", r+1, len(line), g.cols)
+		}
+		for c := 0; c < len(line); c++ {
+			p := Point{r, c}
+			v, err := fn(p, line[c])
+			if err != nil {
+				return nil, fmt.Errorf("row %d, column %d: %w", r+1, c+1, err)
+			}
+			g.Set(p, v)
+		}
+	}
+	return g, nil
+}
+
+// Rows returns the number of rows.
+func (g *Grid[T]) Rows() int { return g.rows }
+
+// Cols returns the number of columns.
+func (g *Grid[T]) Cols() int { return g.cols }
+
+// In reports whether p lies on the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.R >= 0 && p.R < g.rows && p.C >= 0 && p.C < g.cols
+}
+
+// At returns the cell at p, which must be on the grid.
+func (g *Grid[T]) At(p Point) T { return g.cells[p.R*g.cols+p.C] }
+
+// Get returns the cell at p and whether p is on the grid.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.At(p), true
+}
+
+// Set changes the cell at p, which must be on the grid.
+func (g *Grid[T]) Set(p Point, v T) { g.cells[p.R*g.cols+p.C] = v }
+
+// Index returns the position of p in row-major order, for use as a key in
+// flat per-cell slices.
+func (g *Grid[T]) Index(p Point) int { return p.R*g.cols + p.C }
+
+// Point is the inverse of Index.
+func (g *Grid[T]) Point(i int) Point { return Point{i / g.cols, i % g.cols} }
+
+// Neighbors4 returns the orthogonal neighbors of p that are on the grid.
+func (g *Grid[T]) Neighbors4(p Point) []Point {
+	return g.neighbors(p, Dirs4[:])
+}
+
+// Neighbors8 returns the orthogonal and diagonal neighbors of p that are on
+// the grid.
+func (g *Grid[T]) Neighbors8(p Point) []Point {
+	return g.neighbors(p, Dirs8[:])
+}
+
+func (g *Grid[T]) neighbors(p Point, dirs []Point) []Point {
+	out := make([]Point, 0, len(dirs))
+	for _, d := range dirs {
+		if q := p.Add(d); g.In(q) {
+			out = append(out, q)
+		}
+	}
+	return out
+}
+
+// Each calls fn for every cell in row-major order.
+func (g *Grid[T]) Each(fn func(p Point, v T)) {
+	for i, v := range g.cells {
+		fn(g.Point(i), v)
+	}
+}
+
+// FindFunc returns the first cell, in row-major order, for which match is
+// true.
+func (g *Grid[T]) FindFunc(match func(T) bool) (Point, bool) {
+	for i, v := range g.cells {
+		if match(v) {
+			return g.Point(i), true
+		}
+	}
+	return Point{}, false
+}
+
+// Find returns the first cell holding v.
+func Find[T comparable](g *Grid[T], v T) (Point, bool) {
+	return g.FindFunc(func(c T) bool { return c == v })
+}
+
+// FindAll returns every cell holding v in row-major order.
+func FindAll[T comparable](g *Grid[T], v T) []Point {
+	var out []Point
+	for i, c := range g.cells {
+		if c == v {
+			out = append(out, g.Point(i))
+		}
+	}
+	return out
+}
+
+// Row returns a copy of row r.
+func (g *Grid[T]) Row(r int) []T {
+	return append([]T(nil), g.cells[r*g.cols:(r+1)*g.cols]...)
+}
+
+// Col returns a copy of column c.
+func (g *Grid[T]) Col(c int) []T {
+	out := make([]T, g.rows)
+	for r := range out {
+		out[r] = g.cells[r*g.cols+c]
+	}
+	return out
+}
+
+// Clone returns an independent copy of g.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{g.rows, g.cols, append([]T(nil), g.cells...)}
+}
+
+// Transpose returns g mirrored along its main diagonal.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	out := New[T](g.cols, g.rows)
+	g.Each(func(p Point, v T) { out.Set(Point{p.C, p.R}, v) })
+	return out
+}
+
+// RotateRight returns g turned a quarter turn clockwise.
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	out := New[T](g.cols, g.rows)
+	g.Each(func(p Point, v T) { out.Set(Point{p.C, g.rows - 1 - p.R}, v) })
+	return out
+}
+
+// RotateLeft returns g turned a quarter turn counterclockwise.
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	out := New[T](g.cols, g.rows)
+	g.Each(func(p Point, v T) { out.Set(Point{g.cols - 1 - p.C, p.R}, v) })
+	return out
+}
+
+// Format renders the grid one row per line, converting cells with cell.
+func (g *Grid[T]) Format(cell func(T) string) string {
+	var b strings.Builder
+	for i, v := range g.cells {
+		b.WriteString(cell(v))
+		if (i+1)%g.cols == 0 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// String renders byte and rune grids as text and other grids with fmt.
+func (g *Grid[T]) String() string {
+	return g.Format(func(v T) string {
+		switch c := any(v).(type) {
+		case byte:
+			return string(rune(c))
+		case rune:
+			return string(c)
+		case bool:
+			if c {
+				return "#"
+			}
+			return "."
+		}
+		return fmt.Sprint(v)
+	})
+}
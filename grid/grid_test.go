@@ -0,0 +1,90 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	g, err := Parse(strings.NewReader("\nab#\r\nc.d\n\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Rows() != 2 || g.Cols() != 3 {
+		t.Fatalf("size = %dx%d, want 2x3", g.Rows(), g.Cols())
+	}
+	if got := g.String(); got != "ab#\nc.d\n" {
+		t.Errorf("String() = %q", got)
+	}
+	if p, ok := Find(g, '#'); !ok || p != (Point{0, 2}) {
+		t.Errorf("Find('#') = %v, %v", p, ok)
+	}
+	if _, err := Parse(strings.NewReader("ab\nabc\n")); err == nil {
+		t.Error("ragged grid: no error")
+	}
+	_, err = Parse(strings.NewReader("\n\nab\n\nab\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 5:") {
+		t.Errorf("two sections after blank lines: err = %v, want one at line 5", err)
+	}
+	if _, err := Parse(strings.NewReader("\n\n")); !errors.Is(err, ErrEmpty) {
+		t.Errorf("blank input: err = %v, want ErrEmpty", err)
+	}
+
+	// A byte order mark is not part of the first row.
+	for _, read := range []func() (*Grid[byte], error){
+		func() (*Grid[byte], error) { return Parse(strings.NewReader("\ufeffab#\r\nc.d\r\n")) },
+		func() (*Grid[byte], error) { return ParseLines([]string{"\ufeffab#", "c.d"}) },
+	} {
+		g, err := read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := g.String(); got != "ab#\nc.d\n" {
+			t.Errorf("grid after a byte order mark = %q", got)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	if got := g.Neighbors4(Point{0, 0}); !reflect.DeepEqual(got, []Point{{0, 1}, {1, 0}}) {
+		t.Errorf("Neighbors4(corner) = %v", got)
+	}
+	if got := len(g.Neighbors8(Point{1, 1})); got != 8 {
+		t.Errorf("Neighbors8(center) has %d points", got)
+	}
+	if got := len(g.Neighbors8(Point{2, 1})); got != 5 {
+		t.Errorf("Neighbors8(edge) has %d points", got)
+	}
+	if _, ok := g.Get(Point{3, 0}); ok {
+		t.Error("Get outside the grid reports ok")
+	}
+}
+
+func TestTransform(t *testing.T) {
+	g, _ := ParseLines([]string{"abc", "def"})
+	for _, tt := range []struct {
+		name string
+		got  *Grid[byte]
+		want string
+	}{
+		{"Transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"RotateRight", g.RotateRight(), "da\neb\nfc\n"},
+		{"RotateLeft", g.RotateLeft(), "cf\nbe\nad\n"},
+	} {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := string(g.Col(1)); got != "be" {
+		t.Errorf("Col(1) = %q", got)
+	}
+	if got := string(g.Row(1)); got != "def" {
+		t.Errorf("Row(1) = %q", got)
+	}
+	if Up.TurnRight() != Right || Right.TurnLeft() != Up {
+		t.Error("turns do not match Dirs4 order")
+	}
+}
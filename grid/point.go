@@ -0,0 +1,71 @@
+// Package grid provides the two-dimensional grid and the point type shared by
+// the grid puzzles.
+//
+// Positions are always (row, column): R grows downwards and C to the right,
+// and cells are stored row by row. A puzzle that talks about x and y maps x
+// to C and y to R.
+package grid
+
+import "fmt"
+
+// Point is a cell position or a step between cells.
+type Point struct {
+	R, C int
+}
+
+// Steps to the four orthogonal neighbors.
+var (
+	Up    = Point{-1, 0}
+	Right = Point{0, 1}
+	Down  = Point{1, 0}
+	Left  = Point{0, -1}
+)
+
+// Dirs4 lists the orthogonal steps clockwise, starting upwards.
+var Dirs4 = [4]Point{Up, Right, Down, Left}
+
+// Dirs8 lists all eight steps clockwise, starting upwards.
+var Dirs8 = [8]Point{Up, {-1, 1}, Right, {1, 1}, Down, {1, -1}, Left, {-1, -1}}
+
+// Add returns p moved by q.
+func (p Point) Add(q Point) Point { return Point{p.R + q.R, p.C + q.C} }
+
+// Sub returns the step from q to p.
+func (p Point) Sub(q Point) Point { return Point{p.R - q.R, p.C - q.C} }
+
+// Mul returns p scaled by k.
+func (p Point) Mul(k int) Point { return Point{p.R * k, p.C * k} }
+
+// TurnRight returns the step p rotated a quarter turn clockwise.
+func (p Point) TurnRight() Point { return Point{p.C, -p.R} }
+
+// TurnLeft returns the step p rotated a quarter turn counterclockwise.
+func (p Point) TurnLeft() Point { return Point{-p.C, p.R} }
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int { return abs(p.R-q.R) + abs(p.C-q.C) }
+
+// Dir4 returns the step of an arrow character (^ > v <) or, for the letters
+// used by some puzzles, U R D L.
+func Dir4(ch byte) (Point, bool) {
+	switch ch {
+	case '^', 'U':
+		return Up, true
+	case '>', 'R':
+		return Right, true
+	case 'v', 'D':
+		return Down, true
+	case '<', 'L':
+		return Left, true
+	}
+	return Point{}, false
+}
+
+func (p Point) String() string { return fmt.Sprintf("(%d,%d)", p.R, p.C) }
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
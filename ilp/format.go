@@ -0,0 +1,354 @@
+package ilp
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+// ParseLP reads a problem in a small text format, one statement per line:
+//
+//	# presses for the first example machine
+//	min: a + b + c + d + e + f
+//	j0: e + f = 3
+//	j1: b + f = 5
+//	2 c + d/2 + 3*e >= 4
+//	int a, b, c, d, e, f
+//
+// The first statement is the objective, "min:" or "max:" and a linear
+// expression. Each constraint is a linear expression, one of <=, >= and =,
+// and a number, with an optional "name:" in front. "int" lists variables
+// that must be integers. Coefficients are integers, decimals or fractions
+// and may be joined to their variable by "*"; a variable may also be
+// divided by a number, as in "d/2". Variables are created as
+// they appear and are non-negative. Text after # is a comment.
+func ParseLP(r io.Reader) (*Problem, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	p := &Problem{}
+	index := make(map[string]int)
+	lp := lpParser{p: p, index: index}
+	seenObjective := false
+	for _, line := range lines {
+		text := line.Text
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		lp.line, lp.text, lp.pos = line, text, 0
+		lp.skipSpace()
+
+		if lp.keyword("int") {
+			if err := lp.intList(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !seenObjective {
+			switch {
+			case lp.keyword("min:"):
+			case lp.keyword("max:"):
+				p.Maximize = true
+			default:
+				return nil, lp.errorf("want the objective, min: or max:")
+			}
+			obj, err := lp.expr()
+			if err != nil {
+				return nil, err
+			}
+			if lp.pos < len(lp.text) {
+				return nil, lp.errorf("unexpected text after the objective")
+			}
+			p.Objective = obj
+			seenObjective = true
+			continue
+		}
+		c, err := lp.constraint()
+		if err != nil {
+			return nil, err
+		}
+		p.Constraints = append(p.Constraints, c)
+	}
+	if !seenObjective {
+		return nil, fmt.Errorf("no objective")
+	}
+	// Coefficient vectors were built while variables were still being
+	// added; extend them all to full length.
+	p.Objective = pad(p.Objective, len(p.Vars))
+	for i := range p.Constraints {
+		p.Constraints[i].Coef = pad(p.Constraints[i].Coef, len(p.Vars))
+	}
+	return p, nil
+}
+
+type lpParser struct {
+	p     *Problem
+	index map[string]int
+	line  parse.Line
+	text  string
+	pos   int
+}
+
+func (lp *lpParser) errorf(format string, args ...any) error {
+	end := lp.pos
+	for end < len(lp.text) && lp.text[end] != ' ' && lp.text[end] != '\t' {
+		end++
+	}
+	return lp.line.ErrorAt(lp.pos, lp.text[lp.pos:end], fmt.Sprintf(format, args...))
+}
+
+func (lp *lpParser) skipSpace() {
+	for lp.pos < len(lp.text) && (lp.text[lp.pos] == ' ' || lp.text[lp.pos] == '\t') {
+		lp.pos++
+	}
+}
+
+// keyword consumes kw if the line continues with it as a whole word.
+func (lp *lpParser) keyword(kw string) bool {
+	rest := lp.text[lp.pos:]
+	if !strings.HasPrefix(rest, kw) {
+		return false
+	}
+	if len(rest) > len(kw) && isIdent(rest[len(kw)]) && isIdent(kw[len(kw)-1]) {
+		return false
+	}
+	lp.pos += len(kw)
+	lp.skipSpace()
+	return true
+}
+
+func isIdent(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return isIdent(c) && !('0' <= c && c <= '9')
+}
+
+// ident consumes a variable name and returns its index, adding it if new.
+func (lp *lpParser) ident() (int, bool) {
+	start := lp.pos
+	if lp.pos >= len(lp.text) || !isIdentStart(lp.text[lp.pos]) {
+		return 0, false
+	}
+	for lp.pos < len(lp.text) && isIdent(lp.text[lp.pos]) {
+		lp.pos++
+	}
+	name := lp.text[start:lp.pos]
+	lp.skipSpace()
+	i, ok := lp.index[name]
+	if !ok {
+		i = lp.p.AddVar(name, false)
+		lp.index[name] = i
+	}
+	return i, true
+}
+
+// number consumes an unsigned integer, decimal or fraction.
+func (lp *lpParser) number() (*big.Rat, error) {
+	start := lp.pos
+	for lp.pos < len(lp.text) && strings.IndexByte("0123456789./", lp.text[lp.pos]) >= 0 {
+		lp.pos++
+	}
+	tok := lp.text[start:lp.pos]
+	r, ok := new(big.Rat).SetString(tok)
+	if tok == "" || !ok {
+		lp.pos = start
+		return nil, lp.errorf("want a number")
+	}
+	lp.skipSpace()
+	return r, nil
+}
+
+// expr parses a possibly empty sum of terms like "2 x", "-y", "+ 3/2*z"
+// and "w/4". A variable that appears twice has its coefficients added.
+func (lp *lpParser) expr() ([]*big.Rat, error) {
+	var coef []*big.Rat
+	if lp.pos == len(lp.text) || strings.IndexByte("<>=", lp.text[lp.pos]) >= 0 {
+		return coef, nil
+	}
+	for first := true; ; first = false {
+		neg := false
+		switch {
+		case lp.pos < len(lp.text) && (lp.text[lp.pos] == '+' || lp.text[lp.pos] == '-'):
+			neg = lp.text[lp.pos] == '-'
+			lp.pos++
+			lp.skipSpace()
+		case !first:
+			return coef, nil
+		}
+		c := big.NewRat(1, 1)
+		if lp.pos < len(lp.text) && strings.IndexByte("0123456789.", lp.text[lp.pos]) >= 0 {
+			var err error
+			if c, err = lp.number(); err != nil {
+				return nil, err
+			}
+			if lp.pos < len(lp.text) && lp.text[lp.pos] == '*' {
+				lp.pos++
+				lp.skipSpace()
+			}
+		}
+		v, ok := lp.ident()
+		if !ok {
+			return nil, lp.errorf("want a variable")
+		}
+		if lp.pos < len(lp.text) && lp.text[lp.pos] == '/' {
+			lp.pos++
+			lp.skipSpace()
+			at := lp.pos
+			d, err := lp.number()
+			if err != nil {
+				return nil, err
+			}
+			if d.Sign() == 0 {
+				lp.pos = at
+				return nil, lp.errorf("division by zero")
+			}
+			c.Quo(c, d)
+		}
+		if neg {
+			c.Neg(c)
+		}
+		coef = pad(coef, v+1)
+		coef[v].Add(coef[v], c)
+	}
+}
+
+func (lp *lpParser) constraint() (Constraint, error) {
+	var c Constraint
+	// A name is an identifier followed by a colon.
+	if start := lp.pos; lp.pos < len(lp.text) && isIdentStart(lp.text[lp.pos]) {
+		end := start
+		for end < len(lp.text) && isIdent(lp.text[end]) {
+			end++
+		}
+		if end < len(lp.text) && lp.text[end] == ':' {
+			c.Name = lp.text[start:end]
+			lp.pos = end + 1
+			lp.skipSpace()
+		}
+	}
+	coef, err := lp.expr()
+	if err != nil {
+		return c, err
+	}
+	c.Coef = coef
+	switch rest := lp.text[lp.pos:]; {
+	case strings.HasPrefix(rest, "<="):
+		c.Op, lp.pos = LE, lp.pos+2
+	case strings.HasPrefix(rest, ">="):
+		c.Op, lp.pos = GE, lp.pos+2
+	case strings.HasPrefix(rest, "="):
+		c.Op, lp.pos = EQ, lp.pos+1
+	default:
+		return c, lp.errorf("want <=, >= or =")
+	}
+	lp.skipSpace()
+	neg := false
+	if lp.pos < len(lp.text) && lp.text[lp.pos] == '-' {
+		neg = true
+		lp.pos++
+	}
+	if c.RHS, err = lp.number(); err != nil {
+		return c, err
+	}
+	if neg {
+		c.RHS.Neg(c.RHS)
+	}
+	if lp.pos < len(lp.text) {
+		return c, lp.errorf("unexpected text after the constraint")
+	}
+	return c, nil
+}
+
+// intList parses the variables of an int statement, separated by commas or
+// spaces.
+func (lp *lpParser) intList() error {
+	for lp.pos < len(lp.text) {
+		v, ok := lp.ident()
+		if !ok {
+			return lp.errorf("want a variable")
+		}
+		lp.p.Vars[v].Integer = true
+		if lp.pos < len(lp.text) && lp.text[lp.pos] == ',' {
+			lp.pos++
+			lp.skipSpace()
+		}
+	}
+	return nil
+}
+
+// pad extends v with zeros to length n.
+func pad(v []*big.Rat, n int) []*big.Rat {
+	for i := range v {
+		if v[i] == nil {
+			v[i] = new(big.Rat)
+		}
+	}
+	for len(v) < n {
+		v = append(v, new(big.Rat))
+	}
+	return v
+}
+
+// String writes the problem in the format ParseLP reads. Variables that
+// appear nowhere are lost.
+func (p *Problem) String() string {
+	var b strings.Builder
+	if p.Maximize {
+		b.WriteString("max:")
+	} else {
+		b.WriteString("min:")
+	}
+	p.writeExpr(&b, p.Objective)
+	b.WriteByte('\n')
+	for _, c := range p.Constraints {
+		if c.Name != "" {
+			fmt.Fprintf(&b, "%s:", c.Name)
+		}
+		p.writeExpr(&b, c.Coef)
+		fmt.Fprintf(&b, " %v %s\n", c.Op, c.RHS.RatString())
+	}
+	var ints []string
+	for _, v := range p.Vars {
+		if v.Integer {
+			ints = append(ints, v.Name)
+		}
+	}
+	if len(ints) > 0 {
+		fmt.Fprintf(&b, "int %s\n", strings.Join(ints, ", "))
+	}
+	return b.String()
+}
+
+func (p *Problem) writeExpr(b *strings.Builder, coef []*big.Rat) {
+	first := true
+	for i, c := range coef {
+		if c == nil || c.Sign() == 0 {
+			continue
+		}
+		sign := " + "
+		if c.Sign() < 0 {
+			sign = " - "
+		}
+		if first {
+			sign = " "
+			if c.Sign() < 0 {
+				sign = " -"
+			}
+		}
+		first = false
+		b.WriteString(sign)
+		if abs := new(big.Rat).Abs(c); abs.Cmp(big.NewRat(1, 1)) != 0 {
+			b.WriteString(abs.RatString() + " ")
+		}
+		b.WriteString(p.Vars[i].Name)
+	}
+}
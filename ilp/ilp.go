@@ -0,0 +1,282 @@
+// Package ilp solves small integer linear programs exactly. Linear
+// relaxations are solved by the simplex method on rational numbers and
+// integrality is enforced by branch and bound, so answers carry no rounding
+// error.
+//
+// Variables are non-negative. Problems can be built in code or read from a
+// small text format; see ParseLP.
+package ilp
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrInfeasible means no assignment satisfies the constraints.
+	ErrInfeasible = errors.New("problem is infeasible")
+	// ErrUnbounded means the objective can be improved without limit.
+	ErrUnbounded = errors.New("problem is unbounded")
+	// ErrNodeLimit means branch and bound gave up before proving an
+	// optimum. Integer programs whose relaxation is feasible but that have
+	// no integer solution, such as 2x - 2y = 1, can branch forever.
+	ErrNodeLimit = errors.New("branch and bound node limit reached")
+)
+
+// DefaultMaxNodes is the node limit of problems that do not set MaxNodes.
+const DefaultMaxNodes = 10_000
+
+// Op relates the two sides of a constraint.
+type Op int
+
+const (
+	LE Op = iota // <=
+	GE           // >=
+	EQ           // =
+)
+
+func (o Op) String() string {
+	switch o {
+	case LE:
+		return "<="
+	case GE:
+		return ">="
+	case EQ:
+		return "="
+	}
+	return fmt.Sprintf("Op(%d)", int(o))
+}
+
+// Var is a decision variable.
+type Var struct {
+	Name    string
+	Integer bool
+}
+
+// Constraint is Coef · x Op RHS.
+type Constraint struct {
+	// Name is optional.
+	Name string
+	// Coef has one entry per variable; missing and nil entries are zero.
+	Coef []*big.Rat
+	Op   Op
+	RHS  *big.Rat
+}
+
+// Problem is a linear program over non-negative variables, some of which
+// may be required to be integers.
+type Problem struct {
+	Vars []Var
+	// Maximize selects maximization; problems minimize by default.
+	Maximize bool
+	// Objective has one coefficient per variable; missing and nil entries
+	// are zero.
+	Objective   []*big.Rat
+	Constraints []Constraint
+	// MaxNodes limits the linear programs Solve may solve; 0 means
+	// DefaultMaxNodes.
+	MaxNodes int
+}
+
+// AddVar adds a variable and returns its index.
+func (p *Problem) AddVar(name string, integer bool) int {
+	p.Vars = append(p.Vars, Var{name, integer})
+	return len(p.Vars) - 1
+}
+
+// AddConstraint adds the constraint coef · x op rhs.
+func (p *Problem) AddConstraint(name string, coef []*big.Rat, op Op, rhs *big.Rat) {
+	p.Constraints = append(p.Constraints, Constraint{name, coef, op, rhs})
+}
+
+// Ints converts integers to coefficients.
+func Ints(ns ...int64) []*big.Rat {
+	rs := make([]*big.Rat, len(ns))
+	for i, n := range ns {
+		rs[i] = big.NewRat(n, 1)
+	}
+	return rs
+}
+
+// Solution is an optimal assignment.
+type Solution struct {
+	// Value is the objective at X.
+	Value *big.Rat
+	// X has one value per variable.
+	X []*big.Rat
+	// Nodes is the number of linear programs branch and bound solved.
+	Nodes int
+}
+
+// Int returns the value of variable i as an int64. It panics if the value
+// is not an integer that fits.
+func (s *Solution) Int(i int) int64 {
+	if !s.X[i].IsInt() || !s.X[i].Num().IsInt64() {
+		panic(fmt.Sprintf("ilp: variable %d is %v, not an int64", i, s.X[i]))
+	}
+	return s.X[i].Num().Int64()
+}
+
+// SolveRelaxation solves the problem ignoring integrality.
+func (p *Problem) SolveRelaxation() (*Solution, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	sol, err := p.simplex(nil)
+	if err != nil {
+		return nil, err
+	}
+	sol.Nodes = 1
+	return sol, nil
+}
+
+// Solve solves the problem with its integer variables restricted to
+// integers. It returns ErrInfeasible or ErrUnbounded if there is no optimum,
+// and ErrNodeLimit if it runs out of nodes before finding out.
+//
+// Branch and bound solves the relaxation, picks the first integer variable
+// with a fractional value v and solves the two subproblems with the
+// variable at most floor(v) and at least floor(v)+1, dropping subproblems
+// whose relaxation cannot beat the best integer solution found so far.
+func (p *Problem) Solve() (*Solution, error) {
+	if err := p.check(); err != nil {
+		return nil, err
+	}
+	integral := p.integralObjective()
+	var best *Solution
+	nodes, maxNodes := 0, p.MaxNodes
+	if maxNodes <= 0 {
+		maxNodes = DefaultMaxNodes
+	}
+
+	var branch func(bounds []Constraint) error
+	branch = func(bounds []Constraint) error {
+		if nodes == maxNodes {
+			return fmt.Errorf("%w after %d nodes", ErrNodeLimit, nodes)
+		}
+		nodes++
+		sol, err := p.simplex(bounds)
+		if errors.Is(err, ErrInfeasible) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if best != nil && !p.canBeat(sol.Value, best.Value, integral) {
+			return nil
+		}
+		j := p.fractional(sol.X)
+		if j < 0 {
+			best = sol
+			return nil
+		}
+		floor := new(big.Rat).SetInt(ratFloor(sol.X[j]))
+		ceil := new(big.Rat).Add(floor, big.NewRat(1, 1))
+		if err := branch(tighten(bounds, j, LE, floor)); err != nil {
+			return err
+		}
+		return branch(tighten(bounds, j, GE, ceil))
+	}
+
+	if err := branch(nil); err != nil {
+		return nil, err
+	}
+	if best == nil {
+		return nil, ErrInfeasible
+	}
+	best.Nodes = nodes
+	return best, nil
+}
+
+// tighten returns bounds with variable j bounded by op rhs. The new bound
+// replaces any earlier one on the same side of j, which it always
+// tightens, so a deep branch keeps at most two bounds per variable and
+// its linear programs stay small.
+func tighten(bounds []Constraint, j int, op Op, rhs *big.Rat) []Constraint {
+	unit := make([]*big.Rat, j+1)
+	unit[j] = big.NewRat(1, 1)
+	out := make([]Constraint, 0, len(bounds)+1)
+	for _, b := range bounds {
+		if len(b.Coef) != j+1 || b.Op != op {
+			out = append(out, b)
+		}
+	}
+	return append(out, Constraint{Coef: unit, Op: op, RHS: rhs})
+}
+
+// check rejects problems whose vectors are longer than the variable list.
+func (p *Problem) check() error {
+	if len(p.Objective) > len(p.Vars) {
+		return fmt.Errorf("objective has %d coefficients for %d variables", len(p.Objective), len(p.Vars))
+	}
+	for i, c := range p.Constraints {
+		if len(c.Coef) > len(p.Vars) {
+			return fmt.Errorf("constraint %d has %d coefficients for %d variables", i, len(c.Coef), len(p.Vars))
+		}
+		if c.RHS == nil {
+			return fmt.Errorf("constraint %d has no right-hand side", i)
+		}
+	}
+	return nil
+}
+
+// integralObjective reports whether every feasible integer solution has an
+// integer objective value, which lets bounds be rounded.
+func (p *Problem) integralObjective() bool {
+	for i, c := range p.Objective {
+		if c != nil && c.Sign() != 0 && (!p.Vars[i].Integer || !c.IsInt()) {
+			return false
+		}
+	}
+	return true
+}
+
+// canBeat reports whether a relaxation with the given value may contain an
+// integer solution better than best.
+func (p *Problem) canBeat(value, best *big.Rat, integral bool) bool {
+	if integral {
+		if p.Maximize {
+			value = new(big.Rat).SetInt(ratFloor(value))
+		} else {
+			value = new(big.Rat).SetInt(ratCeil(value))
+		}
+	}
+	if p.Maximize {
+		return value.Cmp(best) > 0
+	}
+	return value.Cmp(best) < 0
+}
+
+// fractional returns the first integer variable whose value in x is not an
+// integer, or -1.
+func (p *Problem) fractional(x []*big.Rat) int {
+	for i, v := range p.Vars {
+		if v.Integer && !x[i].IsInt() {
+			return i
+		}
+	}
+	return -1
+}
+
+func ratFloor(r *big.Rat) *big.Int {
+	// Int.Div rounds towards negative infinity for a positive divisor, and
+	// Rat denominators are positive.
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+func ratCeil(r *big.Rat) *big.Int {
+	f := ratFloor(r)
+	if !r.IsInt() {
+		f.Add(f, big.NewInt(1))
+	}
+	return f
+}
+
+// coef returns entry i of v, treating missing and nil entries as zero.
+func coef(v []*big.Rat, i int) *big.Rat {
+	if i < len(v) && v[i] != nil {
+		return v[i]
+	}
+	return new(big.Rat)
+}
@@ -0,0 +1,152 @@
+package ilp
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func mustParse(t *testing.T, text string) *Problem {
+	t.Helper()
+	p, err := ParseLP(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestSolve(t *testing.T) {
+	for _, tt := range []struct {
+		name, lp      string
+		relax, solved string
+	}{
+		{
+			// The relaxation peaks at x = 13/4, y = 3/8; branching finds
+			// x = 3, y = 0.
+			name: "knapsack",
+			lp: `
+max: 5x + 4y
+6x + 4y <= 21
+x + 2y <= 4
+int x, y`,
+			relax: "71/4", solved: "15",
+		},
+		{
+			name: "first day 10 machine",
+			lp: `
+min: a + b + c + d + e + f
+e + f = 3
+b + f = 5
+c + d + e = 4
+a + b + d = 7
+int a b c d e f`,
+			relax: "10", solved: "10",
+		},
+		{
+			name: "surplus and negative right-hand side",
+			lp: `
+min: 2x + 3y
+x + y >= 5/2
+-x + y >= -1
+x - y <= 1`,
+			relax: "23/4", solved: "23/4",
+		},
+		{
+			name: "redundant equality",
+			lp: `
+min: x - y
+x + y = 4
+2x + 2y = 8
+y <= 3
+int x, y`,
+			relax: "-2", solved: "-2",
+		},
+	} {
+		p := mustParse(t, tt.lp)
+		relax, err := p.SolveRelaxation()
+		if err != nil || relax.Value.RatString() != tt.relax {
+			t.Errorf("%s: relaxation = %v, %v, want %s", tt.name, relax, err, tt.relax)
+		}
+		sol, err := p.Solve()
+		if err != nil || sol.Value.RatString() != tt.solved {
+			t.Errorf("%s: Solve = %v, %v, want %s", tt.name, sol, err, tt.solved)
+			continue
+		}
+		for _, c := range p.Constraints {
+			lhs := new(big.Rat)
+			for i, a := range c.Coef {
+				lhs.Add(lhs, new(big.Rat).Mul(a, sol.X[i]))
+			}
+			cmp := lhs.Cmp(c.RHS)
+			if c.Op == LE && cmp > 0 || c.Op == GE && cmp < 0 || c.Op == EQ && cmp != 0 {
+				t.Errorf("%s: solution %v breaks %v %v %v", tt.name, sol.X, c.Coef, c.Op, c.RHS)
+			}
+		}
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	p := mustParse(t, "min: x\nx >= 3\nx <= 2\n")
+	if _, err := p.Solve(); !errors.Is(err, ErrInfeasible) {
+		t.Errorf("infeasible problem: err = %v", err)
+	}
+	// Feasible as a relaxation, but 2x = 3 has no integer solution.
+	p = mustParse(t, "min: x\n2x = 3\nint x\n")
+	if _, err := p.Solve(); !errors.Is(err, ErrInfeasible) {
+		t.Errorf("no integer point: err = %v", err)
+	}
+	p = mustParse(t, "max: x + y\nx - y <= 1\n")
+	if _, err := p.Solve(); !errors.Is(err, ErrUnbounded) {
+		t.Errorf("unbounded problem: err = %v", err)
+	}
+	// Every branch on x or y leaves a feasible relaxation along the line,
+	// so without a limit the search never ends.
+	p = mustParse(t, "min: x\n2x - 2y = 1\nint x, y\n")
+	p.MaxNodes = 1000
+	if _, err := p.Solve(); !errors.Is(err, ErrNodeLimit) {
+		t.Errorf("parity problem: err = %v", err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	text := "min: 2 x - y + 3/2 z\nc1: x + y = 4\n-x + 0.5 z >= 1\nint x, z\n"
+	p := mustParse(t, text)
+	want := "min: 2 x - y + 3/2 z\nc1: x + y = 4\n -x + 1/2 z >= 1\nint x, z\n"
+	if got := p.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if again := mustParse(t, p.String()).String(); again != want {
+		t.Errorf("round trip = %q", again)
+	}
+
+	// The example in the ParseLP documentation.
+	doc := `# presses for the first example machine
+min: a + b + c + d + e + f
+j0: e + f = 3
+j1: b + f = 5
+2 c + d/2 + 3*e >= 4
+int a, b, c, d, e, f
+`
+	if got := mustParse(t, doc).String(); !strings.Contains(got, "\n 2 c + 1/2 d + 3 e >= 4\n") {
+		t.Errorf("documented example reads as %q", got)
+	}
+
+	for text, col := range map[string]int{
+		"x >= 1\n":         1,
+		"min: x +\n":       9,
+		"min: x\nx + 2\n":  6,
+		"min: x\nx <= y\n": 6,
+		"min: 2/0 x\n":     6,
+		"min: x/0\n":       8,
+		"min: x/\n":        8,
+	} {
+		_, err := ParseLP(strings.NewReader(text))
+		var perr *parse.Error
+		if !errors.As(err, &perr) || perr.Col != col {
+			t.Errorf("ParseLP(%q) error = %v, want one at column %d", text, err, col)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package ilp
+
+import "math/big"
+
+// tableau is a simplex tableau in canonical form: the basic column of each
+// row is a unit vector. The last column of every row is its right-hand side,
+// and the objective row z holds the reduced costs, with minus the current
+// objective value last.
+type tableau struct {
+	rows  [][]*big.Rat
+	z     []*big.Rat
+	basis []int
+	cols  int
+}
+
+// simplex solves the relaxation of p with the extra constraints added, by
+// the two-phase method with Bland's rule so that it cannot cycle.
+func (p *Problem) simplex(extra []Constraint) (*Solution, error) {
+	cons := append(p.Constraints[:len(p.Constraints):len(p.Constraints)], extra...)
+	n := len(p.Vars)
+
+	// Columns: the variables, then a slack or surplus column for each
+	// inequality, then an artificial column for each row without an
+	// obvious starting basis.
+	slacks, arts := 0, 0
+	flip := make([]bool, len(cons))
+	for i, c := range cons {
+		op := c.Op
+		if c.RHS.Sign() < 0 {
+			flip[i] = true
+			op = reverse(op)
+		}
+		if op != EQ {
+			slacks++
+		}
+		if op != LE {
+			arts++
+		}
+	}
+	firstArt := n + slacks
+	t := &tableau{cols: firstArt + arts, basis: make([]int, len(cons))}
+	slack, art := n, firstArt
+	for i, c := range cons {
+		row := make([]*big.Rat, t.cols+1)
+		for j := range row {
+			row[j] = new(big.Rat)
+		}
+		for j := 0; j < n; j++ {
+			row[j].Set(coef(c.Coef, j))
+		}
+		row[t.cols].Set(c.RHS)
+		op := c.Op
+		if flip[i] {
+			for _, v := range row {
+				v.Neg(v)
+			}
+			op = reverse(op)
+		}
+		switch op {
+		case LE:
+			row[slack].SetInt64(1)
+			t.basis[i] = slack
+			slack++
+		case GE:
+			row[slack].SetInt64(-1)
+			slack++
+			fallthrough
+		case EQ:
+			row[art].SetInt64(1)
+			t.basis[i] = art
+			art++
+		}
+		t.rows = append(t.rows, row)
+	}
+
+	// Phase 1 minimizes the sum of the artificial variables.
+	if arts > 0 {
+		cost := make([]*big.Rat, t.cols)
+		for j := firstArt; j < t.cols; j++ {
+			cost[j] = big.NewRat(1, 1)
+		}
+		t.setObjective(cost)
+		if !t.optimize(t.cols) {
+			panic("ilp: phase 1 is unbounded")
+		}
+		if t.z[t.cols].Sign() != 0 {
+			return nil, ErrInfeasible
+		}
+		t.dropArtificials(firstArt)
+	}
+
+	// Phase 2 minimizes the real objective over the original columns.
+	cost := make([]*big.Rat, t.cols)
+	for j := 0; j < n; j++ {
+		cost[j] = new(big.Rat).Set(coef(p.Objective, j))
+		if p.Maximize {
+			cost[j].Neg(cost[j])
+		}
+	}
+	t.setObjective(cost)
+	if !t.optimize(firstArt) {
+		return nil, ErrUnbounded
+	}
+
+	x := make([]*big.Rat, n)
+	for j := range x {
+		x[j] = new(big.Rat)
+	}
+	for i, b := range t.basis {
+		if b < n {
+			x[b].Set(t.rows[i][t.cols])
+		}
+	}
+	value := new(big.Rat)
+	for j := range x {
+		value.Add(value, new(big.Rat).Mul(coef(p.Objective, j), x[j]))
+	}
+	return &Solution{Value: value, X: x}, nil
+}
+
+func reverse(op Op) Op {
+	switch op {
+	case LE:
+		return GE
+	case GE:
+		return LE
+	}
+	return op
+}
+
+// setObjective prices out the basis to get the reduced costs of cost; nil
+// entries are zero.
+func (t *tableau) setObjective(cost []*big.Rat) {
+	t.z = make([]*big.Rat, t.cols+1)
+	for j := range t.z {
+		t.z[j] = new(big.Rat)
+		if j < t.cols && cost[j] != nil {
+			t.z[j].Set(cost[j])
+		}
+	}
+	tmp := new(big.Rat)
+	for i, b := range t.basis {
+		cb := cost[b]
+		if cb == nil || cb.Sign() == 0 {
+			continue
+		}
+		for j, v := range t.rows[i] {
+			t.z[j].Sub(t.z[j], tmp.Mul(cb, v))
+		}
+	}
+}
+
+// optimize pivots until no column before limit has a negative reduced cost,
+// and reports false if the objective is unbounded.
+func (t *tableau) optimize(limit int) bool {
+	for {
+		enter := -1
+		for j := 0; j < limit; j++ {
+			if t.z[j].Sign() < 0 {
+				enter = j
+				break
+			}
+		}
+		if enter < 0 {
+			return true
+		}
+		leave := -1
+		var best, ratio big.Rat
+		for i, row := range t.rows {
+			if row[enter].Sign() <= 0 {
+				continue
+			}
+			ratio.Quo(row[t.cols], row[enter])
+			if leave < 0 {
+				leave = i
+				best.Set(&ratio)
+				continue
+			}
+			if c := ratio.Cmp(&best); c < 0 || c == 0 && t.basis[i] < t.basis[leave] {
+				leave = i
+				best.Set(&ratio)
+			}
+		}
+		if leave < 0 {
+			return false
+		}
+		t.pivot(leave, enter)
+	}
+}
+
+// pivot makes column c basic in row r.
+func (t *tableau) pivot(r, c int) {
+	pr := t.rows[r]
+	inv := new(big.Rat).Inv(pr[c])
+	for _, v := range pr {
+		v.Mul(v, inv)
+	}
+	tmp := new(big.Rat)
+	eliminate := func(row []*big.Rat) {
+		if row[c].Sign() == 0 {
+			return
+		}
+		f := new(big.Rat).Set(row[c])
+		for j, v := range pr {
+			if v.Sign() != 0 {
+				row[j].Sub(row[j], tmp.Mul(f, v))
+			}
+		}
+	}
+	for i, row := range t.rows {
+		if i != r {
+			eliminate(row)
+		}
+	}
+	eliminate(t.z)
+	t.basis[r] = c
+}
+
+// dropArtificials pivots the artificial columns, those from first on, out
+// of the basis after a successful phase 1. They are all zero by then; a row
+// that has no other column to pivot on is redundant and is removed.
+func (t *tableau) dropArtificials(first int) {
+	for i := 0; i < len(t.rows); {
+		if t.basis[i] < first {
+			i++
+			continue
+		}
+		c := -1
+		for j := 0; j < first; j++ {
+			if t.rows[i][j].Sign() != 0 {
+				c = j
+				break
+			}
+		}
+		if c < 0 {
+			t.rows = append(t.rows[:i], t.rows[i+1:]...)
+			t.basis = append(t.basis[:i], t.basis[i+1:]...)
+			continue
+		}
+		t.pivot(i, c)
+		i++
+	}
+}
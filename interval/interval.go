@@ -0,0 +1,191 @@
+// Package interval handles sets of int64 values stored as ranges, such as
+// the ID ranges of 2025 days 2 and 5.
+//
+// Intervals are half-open: Interval{3, 6} holds 3, 4 and 5. Puzzles usually
+// give inclusive ranges; Closed converts them.
+package interval
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Interval is the half-open range [Lo, Hi). It is empty if Hi <= Lo.
+type Interval struct {
+	Lo, Hi int64
+}
+
+// Closed returns the interval holding first through last inclusive.
+func Closed(first, last int64) Interval {
+	return Interval{first, last + 1}
+}
+
+// Last returns the largest value in a non-empty interval.
+func (i Interval) Last() int64 {
+	return i.Hi - 1
+}
+
+// Empty reports whether the interval holds no values.
+func (i Interval) Empty() bool {
+	return i.Hi <= i.Lo
+}
+
+// Len returns the number of values in the interval.
+func (i Interval) Len() int64 {
+	if i.Empty() {
+		return 0
+	}
+	return i.Hi - i.Lo
+}
+
+// Contains reports whether x is in the interval.
+func (i Interval) Contains(x int64) bool {
+	return i.Lo <= x && x < i.Hi
+}
+
+// Overlaps reports whether the intervals have a value in common.
+func (i Interval) Overlaps(j Interval) bool {
+	return !i.Intersect(j).Empty()
+}
+
+// Intersect returns the values in both intervals.
+func (i Interval) Intersect(j Interval) Interval {
+	return Interval{max(i.Lo, j.Lo), min(i.Hi, j.Hi)}
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", i.Lo, i.Hi)
+}
+
+// Set is a set of int64 values. It keeps its intervals sorted, non-empty
+// and apart, so neighbouring intervals neither overlap nor touch. The zero
+// Set is empty. Sets are values: the operations return new sets and never
+// modify their operands.
+type Set struct {
+	ivs []Interval
+}
+
+// NewSet returns the union of the intervals.
+func NewSet(ivs ...Interval) Set {
+	sorted := make([]Interval, 0, len(ivs))
+	for _, iv := range ivs {
+		if !iv.Empty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		switch {
+		case a.Lo < b.Lo:
+			return -1
+		case a.Lo > b.Lo:
+			return 1
+		}
+		return 0
+	})
+	var merged []Interval
+	for _, iv := range sorted {
+		if n := len(merged); n > 0 && iv.Lo <= merged[n-1].Hi {
+			merged[n-1].Hi = max(merged[n-1].Hi, iv.Hi)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return Set{merged}
+}
+
+// Intervals returns the intervals of the set in increasing order.
+func (s Set) Intervals() []Interval {
+	return slices.Clone(s.ivs)
+}
+
+// Len returns the number of values in the set.
+func (s Set) Len() int64 {
+	var n int64
+	for _, iv := range s.ivs {
+		n += iv.Len()
+	}
+	return n
+}
+
+// find returns the index of the first interval that ends after x.
+func (s Set) find(x int64) int {
+	return sort.Search(len(s.ivs), func(i int) bool { return s.ivs[i].Hi > x })
+}
+
+// Contains reports whether x is in the set.
+func (s Set) Contains(x int64) bool {
+	i := s.find(x)
+	return i < len(s.ivs) && s.ivs[i].Lo <= x
+}
+
+// ContainsInterval reports whether every value of iv is in the set. The
+// empty interval is in every set.
+func (s Set) ContainsInterval(iv Interval) bool {
+	if iv.Empty() {
+		return true
+	}
+	i := s.find(iv.Lo)
+	return i < len(s.ivs) && s.ivs[i].Lo <= iv.Lo && iv.Hi <= s.ivs[i].Hi
+}
+
+// Overlaps reports whether some value of iv is in the set.
+func (s Set) Overlaps(iv Interval) bool {
+	if iv.Empty() {
+		return false
+	}
+	i := s.find(iv.Lo)
+	return i < len(s.ivs) && s.ivs[i].Lo < iv.Hi
+}
+
+// Add returns the set with the intervals added.
+func (s Set) Add(ivs ...Interval) Set {
+	return NewSet(append(slices.Clone(s.ivs), ivs...)...)
+}
+
+// Union returns the values in either set.
+func (s Set) Union(t Set) Set {
+	return s.Add(t.ivs...)
+}
+
+// Intersect returns the values in both sets.
+func (s Set) Intersect(t Set) Set {
+	var out []Interval
+	for i, j := 0, 0; i < len(s.ivs) && j < len(t.ivs); {
+		if iv := s.ivs[i].Intersect(t.ivs[j]); !iv.Empty() {
+			out = append(out, iv)
+		}
+		if s.ivs[i].Hi < t.ivs[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Set{out}
+}
+
+// Difference returns the values in s that are not in t.
+func (s Set) Difference(t Set) Set {
+	var out []Interval
+	j := 0
+	for _, iv := range s.ivs {
+		for j < len(t.ivs) && t.ivs[j].Hi <= iv.Lo {
+			j++
+		}
+		lo := iv.Lo
+		for k := j; k < len(t.ivs) && t.ivs[k].Lo < iv.Hi; k++ {
+			if t.ivs[k].Lo > lo {
+				out = append(out, Interval{lo, t.ivs[k].Lo})
+			}
+			lo = t.ivs[k].Hi
+		}
+		if lo < iv.Hi {
+			out = append(out, Interval{lo, iv.Hi})
+		}
+	}
+	return Set{out}
+}
+
+func (s Set) String() string {
+	return fmt.Sprint(s.ivs)
+}
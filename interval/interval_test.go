@@ -0,0 +1,72 @@
+package interval
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+func TestNewSet(t *testing.T) {
+	s := NewSet(Closed(3, 5), Closed(10, 14), Closed(16, 20), Closed(12, 18), Closed(6, 6), Interval{9, 9})
+	want := []Interval{{3, 7}, {10, 21}}
+	if got := s.Intervals(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intervals = %v, want %v", got, want)
+	}
+	if s.Len() != 15 {
+		t.Errorf("Len = %d, want 15", s.Len())
+	}
+	for x, want := range map[int64]bool{2: false, 3: true, 6: true, 7: false, 9: false, 10: true, 20: true, 21: false} {
+		if got := s.Contains(x); got != want {
+			t.Errorf("Contains(%d) = %v", x, got)
+		}
+	}
+	if !s.ContainsInterval(Closed(11, 20)) || s.ContainsInterval(Closed(5, 10)) {
+		t.Error("ContainsInterval is wrong")
+	}
+	if !s.Overlaps(Closed(0, 3)) || s.Overlaps(Closed(7, 9)) {
+		t.Error("Overlaps is wrong")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(Interval{0, 10}, Interval{20, 30})
+	b := NewSet(Interval{5, 25}, Interval{28, 40})
+	for _, tt := range []struct {
+		name string
+		got  Set
+		want []Interval
+	}{
+		{"Union", a.Union(b), []Interval{{0, 40}}},
+		{"Intersect", a.Intersect(b), []Interval{{5, 10}, {20, 25}, {28, 30}}},
+		{"Difference", a.Difference(b), []Interval{{0, 5}, {25, 28}}},
+		{"Difference reversed", b.Difference(a), []Interval{{10, 20}, {30, 40}}},
+		{"Difference empty", a.Difference(a), nil},
+	} {
+		if got := tt.got.Intervals(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if a.Len() != 20 {
+		t.Error("operations modified their operand")
+	}
+}
+
+func TestParseLine(t *testing.T) {
+	ivs, err := ParseLine(parse.Line{No: 1, Text: "11-22, 95-115,-3--1"}, ",")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Interval{{11, 23}, {95, 116}, {-3, 0}}; !reflect.DeepEqual(ivs, want) {
+		t.Errorf("ParseLine = %v, want %v", ivs, want)
+	}
+
+	for text, col := range map[string]int{"3-5,7": 5, "3-x": 3, "5-3": 1, "3-5,": 5} {
+		_, err := ParseLine(parse.Line{No: 2, Text: text}, ",")
+		var perr *parse.Error
+		if !errors.As(err, &perr) || perr.Line != 2 || perr.Col != col {
+			t.Errorf("ParseLine(%q) error = %v, want one at column %d", text, err, col)
+		}
+	}
+}
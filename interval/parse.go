@@ -0,0 +1,58 @@
+package interval
+
+import (
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/parse"
+)
+
+// ParseLine parses the inclusive ranges "first-last" on a line, separated
+// by sep, as in "11-22,95-115". With an empty sep the whole line is one
+// range. Errors point at the offending range.
+func ParseLine(l parse.Line, sep string) ([]Interval, error) {
+	fields := []string{l.Text}
+	if sep != "" {
+		fields = strings.Split(l.Text, sep)
+	}
+	var ivs []Interval
+	off := 0
+	for _, f := range fields {
+		iv, err := parseRange(l, off, f)
+		if err != nil {
+			return nil, err
+		}
+		ivs = append(ivs, iv)
+		off += len(f) + len(sep)
+	}
+	return ivs, nil
+}
+
+// parseRange parses the range f found at byte offset off of the line. The
+// dash is looked for after the first character so that a negative first
+// value parses.
+func parseRange(l parse.Line, off int, f string) (Interval, error) {
+	trimmed := strings.TrimLeft(f, " \t")
+	off += len(f) - len(trimmed)
+	trimmed = strings.TrimRight(trimmed, " \t")
+	dash := -1
+	if len(trimmed) > 1 {
+		if i := strings.Index(trimmed[1:], "-"); i >= 0 {
+			dash = i + 1
+		}
+	}
+	if dash < 0 {
+		return Interval{}, l.ErrorAt(off, trimmed, "want a range like 3-5")
+	}
+	first, err := l.Int(off, trimmed[:dash])
+	if err != nil {
+		return Interval{}, err
+	}
+	last, err := l.Int(off+dash+1, trimmed[dash+1:])
+	if err != nil {
+		return Interval{}, err
+	}
+	if last < first {
+		return Interval{}, l.ErrorAt(off, trimmed, "range ends before it starts")
+	}
+	return Closed(int64(first), int64(last)), nil
+}
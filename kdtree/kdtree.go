@@ -0,0 +1,218 @@
+// Package kdtree indexes points in three dimensions for nearest-neighbour
+// queries, such as the junction boxes of 2025 day 8.
+//
+// Points are referred to by their index in the slice the tree was built
+// from. Distances are squared Euclidean distances, exact in int64, and ties
+// between equally distant points go to the lower index, so every query has
+// a single right answer.
+package kdtree
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Point is a point in space.
+type Point struct {
+	X, Y, Z int64
+}
+
+func (p Point) String() string { return fmt.Sprintf("%d,%d,%d", p.X, p.Y, p.Z) }
+
+// Dist2 returns the squared distance between p and q.
+func (p Point) Dist2(q Point) int64 {
+	dx, dy, dz := p.X-q.X, p.Y-q.Y, p.Z-q.Z
+	return dx*dx + dy*dy + dz*dz
+}
+
+func (p Point) coord(axis int) int64 {
+	switch axis {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	}
+	return p.Z
+}
+
+// Tree is a static k-d tree. It is laid out implicitly: the subtree for
+// idx[lo:hi] has its splitting point at idx[(lo+hi)/2], the lower half to
+// the left and the upper half to the right. Slices indexed by the splitting
+// position describe whole subtrees.
+type Tree struct {
+	pts  []Point
+	idx  []int
+	axis []int8
+
+	// low and high are the corners of each subtree's bounding box.
+	low, high []Point
+}
+
+// New builds a tree over pts, splitting each subtree across the axis on
+// which its points are most spread out. The tree keeps pts, which must not
+// change while it is in use.
+func New(pts []Point) *Tree {
+	n := len(pts)
+	t := &Tree{pts: pts, idx: make([]int, n), axis: make([]int8, n), low: make([]Point, n), high: make([]Point, n)}
+	for i := range t.idx {
+		t.idx[i] = i
+	}
+	t.build(0, len(pts))
+	return t
+}
+
+func (t *Tree) build(lo, hi int) {
+	if lo >= hi {
+		return
+	}
+	first := t.pts[t.idx[lo]]
+	low, high := first, first
+	for _, i := range t.idx[lo+1 : hi] {
+		p := t.pts[i]
+		low = Point{min(low.X, p.X), min(low.Y, p.Y), min(low.Z, p.Z)}
+		high = Point{max(high.X, p.X), max(high.Y, p.Y), max(high.Z, p.Z)}
+	}
+	axis := 0
+	for a := 1; a < 3; a++ {
+		if high.coord(a)-low.coord(a) > high.coord(axis)-low.coord(axis) {
+			axis = a
+		}
+	}
+	slices.SortFunc(t.idx[lo:hi], func(a, b int) int {
+		return cmp.Compare(t.pts[a].coord(axis), t.pts[b].coord(axis))
+	})
+	m := (lo + hi) / 2
+	t.axis[m] = int8(axis)
+	t.low[m], t.high[m] = low, high
+	t.build(lo, m)
+	t.build(m+1, hi)
+}
+
+// Len returns the number of points in the tree.
+func (t *Tree) Len() int {
+	return len(t.pts)
+}
+
+// Point returns the point with index i.
+func (t *Tree) Point(i int) Point {
+	return t.pts[i]
+}
+
+// Neighbor is a point found by a query and its squared distance from the
+// query point.
+type Neighbor struct {
+	Index int
+	Dist2 int64
+}
+
+func (n Neighbor) less(m Neighbor) bool {
+	return n.Dist2 < m.Dist2 || n.Dist2 == m.Dist2 && n.Index < m.Index
+}
+
+// Nearest returns the k points closest to q, nearest first, among those
+// for which keep returns true. A nil keep considers every point, including
+// any at q itself. Fewer than k are returned if fewer are kept.
+func (t *Tree) Nearest(q Point, k int, keep func(i int) bool) []Neighbor {
+	if k <= 0 {
+		return nil
+	}
+	s := &searcher{t: t, q: q, k: k, keep: keep, limit: math.MaxInt64}
+	s.search(0, len(t.idx))
+	best := []Neighbor(s.best)
+	slices.SortFunc(best, func(a, b Neighbor) int {
+		if a.less(b) {
+			return -1
+		}
+		return 1
+	})
+	return best
+}
+
+// searcher runs one query, holding the k best points found so far in a
+// max-heap so that the worst of them is at the top.
+type searcher struct {
+	t    *Tree
+	q    Point
+	k    int
+	keep func(i int) bool
+
+	// prune, if set, skips whole subtrees by their splitting position.
+	prune func(m int) bool
+
+	// limit is the greatest distance worth finding.
+	limit int64
+
+	best farthestFirst
+}
+
+func (s *searcher) search(lo, hi int) {
+	if lo >= hi {
+		return
+	}
+	m := (lo + hi) / 2
+	// With ties going to the lower index, a point exactly as far as the
+	// current worst may still win, so only strictly farther boxes are cut.
+	if s.t.boxDist2(m, s.q) > s.limit || s.prune != nil && s.prune(m) {
+		return
+	}
+	i := s.t.idx[m]
+	p := s.t.pts[i]
+	if s.keep == nil || s.keep(i) {
+		s.offer(Neighbor{i, p.Dist2(s.q)})
+	}
+	if s.q.coord(int(s.t.axis[m])) < p.coord(int(s.t.axis[m])) {
+		s.search(lo, m)
+		s.search(m+1, hi)
+	} else {
+		s.search(m+1, hi)
+		s.search(lo, m)
+	}
+}
+
+func (s *searcher) offer(n Neighbor) {
+	switch {
+	case n.Dist2 > s.limit:
+		return
+	case len(s.best) < s.k:
+		heap.Push(&s.best, n)
+	case n.less(s.best[0]):
+		s.best[0] = n
+		heap.Fix(&s.best, 0)
+	default:
+		return
+	}
+	if len(s.best) == s.k {
+		s.limit = s.best[0].Dist2
+	}
+}
+
+// boxDist2 returns the squared distance from q to the nearest point of the
+// bounding box of the subtree split at m.
+func (t *Tree) boxDist2(m int, q Point) int64 {
+	var d int64
+	for a := 0; a < 3; a++ {
+		v := q.coord(a)
+		if lo := t.low[m].coord(a); v < lo {
+			d += (lo - v) * (lo - v)
+		} else if hi := t.high[m].coord(a); v > hi {
+			d += (v - hi) * (v - hi)
+		}
+	}
+	return d
+}
+
+type farthestFirst []Neighbor
+
+func (h farthestFirst) Len() int           { return len(h) }
+func (h farthestFirst) Less(i, j int) bool { return h[j].less(h[i]) }
+func (h farthestFirst) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *farthestFirst) Push(x any)        { *h = append(*h, x.(Neighbor)) }
+func (h *farthestFirst) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,123 @@
+package kdtree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomPoints returns n points with coordinates below size; a small size
+// makes many ties and repeated points.
+func randomPoints(rng *rand.Rand, n int, size int64) []Point {
+	pts := make([]Point, n)
+	for i := range pts {
+		pts[i] = Point{rng.Int63n(size), rng.Int63n(size), rng.Int63n(size)}
+	}
+	return pts
+}
+
+func allPairs(pts []Point) []Pair {
+	var pairs []Pair
+	for i := range pts {
+		for j := i + 1; j < len(pts); j++ {
+			pairs = append(pairs, Pair{i, j, pts[i].Dist2(pts[j])})
+		}
+	}
+	slices.SortFunc(pairs, func(a, b Pair) int {
+		if a.Less(b) {
+			return -1
+		}
+		return 1
+	})
+	return pairs
+}
+
+func TestNearest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, size := range []int64{3, 50, 1e6} {
+		pts := randomPoints(rng, 200, size)
+		tree := New(pts)
+		for trial := 0; trial < 50; trial++ {
+			q := randomPoints(rng, 1, size)[0]
+			k := rng.Intn(10) + 1
+			odd := func(i int) bool { return i%2 == 1 }
+
+			var want []Neighbor
+			for i, p := range pts {
+				if odd(i) {
+					want = append(want, Neighbor{i, p.Dist2(q)})
+				}
+			}
+			slices.SortFunc(want, func(a, b Neighbor) int {
+				if a.less(b) {
+					return -1
+				}
+				return 1
+			})
+			want = want[:k]
+
+			if got := tree.Nearest(q, k, odd); !slices.Equal(got, want) {
+				t.Fatalf("size %d: Nearest(%v, %d) = %v, want %v", size, q, k, got, want)
+			}
+		}
+	}
+	if got := New(nil).Nearest(Point{}, 3, nil); len(got) != 0 {
+		t.Errorf("empty tree found %v", got)
+	}
+}
+
+func TestPairs(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, size := range []int64{3, 50, 1e6} {
+		pts := randomPoints(rng, 120, size)
+		want := allPairs(pts)
+		ps := New(pts).Pairs()
+		for k, w := range want {
+			got, ok := ps.Next()
+			if !ok || got != w {
+				t.Fatalf("size %d: pair %d = %v, %t, want %v", size, k, got, ok, w)
+			}
+		}
+		if p, ok := ps.Next(); ok {
+			t.Fatalf("size %d: stream went on past the last pair with %v", size, p)
+		}
+	}
+}
+
+func TestSpanningTree(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for _, size := range []int64{3, 50, 1e6} {
+		pts := randomPoints(rng, 150, size)
+
+		// Kruskal over every pair is the reference.
+		parent := make([]int, len(pts))
+		for i := range parent {
+			parent[i] = i
+		}
+		var find func(int) int
+		find = func(i int) int {
+			for parent[i] != i {
+				i = parent[i]
+			}
+			return i
+		}
+		var want []Pair
+		for _, p := range allPairs(pts) {
+			if a, b := find(p.I), find(p.J); a != b {
+				parent[a] = b
+				want = append(want, p)
+			}
+		}
+
+		if got := New(pts).SpanningTree(); !slices.Equal(got, want) {
+			t.Fatalf("size %d: SpanningTree differs from Kruskal:\ngot  %v\nwant %v", size, got, want)
+		}
+	}
+}
+
+func BenchmarkSpanningTree(b *testing.B) {
+	pts := randomPoints(rand.New(rand.NewSource(4)), 100_000, 1e6)
+	for i := 0; i < b.N; i++ {
+		New(pts).SpanningTree()
+	}
+}
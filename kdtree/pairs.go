@@ -0,0 +1,196 @@
+package kdtree
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/graph"
+)
+
+// Pair is two distinct points, I < J, and their squared distance.
+type Pair struct {
+	I, J  int
+	Dist2 int64
+}
+
+// Less orders pairs by distance, then by I, then by J.
+func (p Pair) Less(q Pair) bool {
+	if p.Dist2 != q.Dist2 {
+		return p.Dist2 < q.Dist2
+	}
+	if p.I != q.I {
+		return p.I < q.I
+	}
+	return p.J < q.J
+}
+
+// Pairs streams every pair of points in the order of Pair.Less, closest
+// first. Each point keeps a buffer of its next neighbours with higher
+// indices, refilled by a query for twice as many when it runs dry, and a
+// heap picks the point whose next pair is closest. Taking m pairs costs
+// about (n + m) log n.
+type Pairs struct {
+	t       *Tree
+	next    [][]Neighbor
+	fetched []int
+	done    []bool
+	heads   pairHeap
+}
+
+// Pairs returns a stream of the tree's pairs.
+func (t *Tree) Pairs() *Pairs {
+	n := t.Len()
+	ps := &Pairs{t: t, next: make([][]Neighbor, n), fetched: make([]int, n), done: make([]bool, n)}
+	for i := range ps.next {
+		if p, ok := ps.fill(i); ok {
+			ps.heads = append(ps.heads, p)
+		}
+	}
+	heap.Init(&ps.heads)
+	return ps
+}
+
+// fill loads point i's next batch of neighbours and returns the closest.
+func (ps *Pairs) fill(i int) (Pair, bool) {
+	want := max(2*ps.fetched[i], 4)
+	found := ps.t.Nearest(ps.t.pts[i], want, func(j int) bool { return j > i })
+	ps.done[i] = len(found) < want
+	ps.next[i] = found[ps.fetched[i]:]
+	ps.fetched[i] = len(found)
+	if len(ps.next[i]) == 0 {
+		return Pair{}, false
+	}
+	return ps.head(i), true
+}
+
+func (ps *Pairs) head(i int) Pair {
+	n := ps.next[i][0]
+	return Pair{i, n.Index, n.Dist2}
+}
+
+// Next returns the next closest pair, or false once every pair has been
+// returned.
+func (ps *Pairs) Next() (Pair, bool) {
+	if len(ps.heads) == 0 {
+		return Pair{}, false
+	}
+	p := heap.Pop(&ps.heads).(Pair)
+	i := p.I
+	ps.next[i] = ps.next[i][1:]
+	if len(ps.next[i]) > 0 {
+		heap.Push(&ps.heads, ps.head(i))
+	} else if !ps.done[i] {
+		if next, ok := ps.fill(i); ok {
+			heap.Push(&ps.heads, next)
+		}
+	}
+	return p, true
+}
+
+type pairHeap []Pair
+
+func (h pairHeap) Len() int           { return len(h) }
+func (h pairHeap) Less(i, j int) bool { return h[i].Less(h[j]) }
+func (h pairHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *pairHeap) Push(x any)        { *h = append(*h, x.(Pair)) }
+func (h *pairHeap) Pop() any {
+	old := *h
+	p := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return p
+}
+
+// SpanningTree returns the edges of the minimum spanning tree, in the order
+// of Pair.Less, which is the order Kruskal's algorithm would join them in.
+// Since Pair.Less breaks every tie the tree is unique.
+//
+// It runs Borůvka's algorithm: each round finds, for every component, its
+// shortest pair leaving the component, and joins along all of them. The
+// nearest-neighbour query for a point skips subtrees that lie entirely in
+// the point's own component, so a round costs about n log n and there are
+// at most log n rounds.
+func (t *Tree) SpanningTree() []Pair {
+	n := t.Len()
+	uf := graph.NewUnionFind(n)
+	comp := make([]int, n)
+	// uniform[m] is the component of every point in the subtree split at
+	// m, or -1 if they are not all in one.
+	uniform := make([]int, n)
+	// reach[i] is a lower bound on the distance from point i to the nearest
+	// point outside its component. Components only grow, so a bound from
+	// an earlier round still holds.
+	reach := make([]int64, n)
+	var edges []Pair
+	for uf.Sets() > 1 {
+		for i := range comp {
+			comp[i] = uf.Find(i)
+		}
+		t.markUniform(0, n, comp, uniform)
+
+		best := make(map[int]Pair)
+		// Neighbouring points tend to share a component and a nearest
+		// outsider, so visiting them in tree order tightens the limits
+		// early.
+		for _, i := range t.idx {
+			p, c := t.pts[i], comp[i]
+			s := &searcher{
+				t:     t,
+				q:     p,
+				k:     1,
+				keep:  func(j int) bool { return comp[j] != c },
+				prune: func(m int) bool { return uniform[m] == c },
+				limit: math.MaxInt64,
+			}
+			// Nothing farther than the component's best so far can help.
+			if e, ok := best[c]; ok {
+				if reach[i] > e.Dist2 {
+					continue
+				}
+				s.limit = e.Dist2
+			}
+			s.search(0, n)
+			if len(s.best) == 0 {
+				// Everything outside is beyond the limit.
+				reach[i] = s.limit + 1
+				continue
+			}
+			nb := s.best[0]
+			reach[i] = nb.Dist2
+			e := Pair{min(i, nb.Index), max(i, nb.Index), nb.Dist2}
+			if old, ok := best[c]; !ok || e.Less(old) {
+				best[c] = e
+			}
+		}
+		for _, e := range best {
+			if uf.Union(e.I, e.J) {
+				edges = append(edges, e)
+			}
+		}
+	}
+	slices.SortFunc(edges, func(a, b Pair) int {
+		if a.Less(b) {
+			return -1
+		}
+		return 1
+	})
+	return edges
+}
+
+// markUniform fills uniform for the subtree idx[lo:hi] and returns its
+// component, or -1 if it spans several. An empty subtree returns -2, which
+// agrees with any component.
+func (t *Tree) markUniform(lo, hi int, comp, uniform []int) int {
+	if lo >= hi {
+		return -2
+	}
+	m := (lo + hi) / 2
+	c := comp[t.idx[m]]
+	for _, sub := range []int{t.markUniform(lo, m, comp, uniform), t.markUniform(m+1, hi, comp, uniform)} {
+		if sub != -2 && sub != c {
+			c = -1
+		}
+	}
+	uniform[m] = c
+	return c
+}
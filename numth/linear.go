@@ -0,0 +1,86 @@
+package numth
+
+import "math/bits"
+
+// Linear describes every integer solution of a*x + b*y = c as
+// x = X + k*DX, y = Y - k*DY for integer k.
+type Linear struct {
+	X, Y, DX, DY int64
+}
+
+// SolveLinear returns the integer solutions of a*x + b*y = c, and false if
+// there are none or they do not fit in an int64. a and b must not both be
+// zero. X is the smallest non-negative x, unless DX is 0.
+func SolveLinear(a, b, c int64) (Linear, bool) {
+	g, p, _ := ExtGCD(a, b)
+	if g == 0 || c%g != 0 {
+		return Linear{}, false
+	}
+	switch {
+	case b == 0:
+		// a*x = c fixes x and leaves y free.
+		return Linear{X: c / a, DY: 1}, true
+	case a == 0:
+		return Linear{Y: c / b, DX: 1}, true
+	}
+	dx, dy := b/g, a/g
+	if dx < 0 {
+		dx, dy = -dx, -dy
+	}
+	// a*p ≡ g (mod b), so x ≡ c/g * p (mod b/g).
+	x := MulMod(c/g, p, dx)
+	ax, ok := MulChecked(a, x)
+	if !ok {
+		return Linear{}, false
+	}
+	rest, ok := AddChecked(c, -ax)
+	if !ok {
+		return Linear{}, false
+	}
+	return Linear{X: x, Y: rest / b, DX: dx, DY: dy}, true
+}
+
+// MinCostNonNeg returns the solution of a*x + b*y = c with x, y >= 0 that
+// minimizes costX*x + costY*y, and false if there is none. All arguments
+// must be non-negative. Of equally cheap solutions it returns the one with
+// the smallest x.
+func MinCostNonNeg(a, b, c, costX, costY int64) (x, y int64, ok bool) {
+	switch {
+	case a == 0 && b == 0:
+		return 0, 0, c == 0
+	case a == 0:
+		return 0, c / b, c%b == 0
+	case b == 0:
+		return c / a, 0, c%a == 0
+	}
+	sol, ok := SolveLinear(a, b, c)
+	if !ok || sol.Y < 0 {
+		// X is the smallest non-negative x, so Y is the largest y.
+		return 0, 0, false
+	}
+	// Moving along the solutions by one step changes the cost by
+	// costX*DX - costY*DY. If that is negative, go as far as y allows.
+	if cmpMul(costX, sol.DX, costY, sol.DY) < 0 {
+		k := sol.Y / sol.DY
+		return sol.X + k*sol.DX, sol.Y - k*sol.DY, true
+	}
+	return sol.X, sol.Y, true
+}
+
+// cmpMul compares a*b with c*d for non-negative operands, in 128 bits.
+func cmpMul(a, b, c, d int64) int {
+	h1, l1 := bits.Mul64(uint64(a), uint64(b))
+	h2, l2 := bits.Mul64(uint64(c), uint64(d))
+	switch {
+	case h1 != h2:
+		if h1 < h2 {
+			return -1
+		}
+		return 1
+	case l1 < l2:
+		return -1
+	case l1 > l2:
+		return 1
+	}
+	return 0
+}
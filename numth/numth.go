@@ -0,0 +1,155 @@
+// Package numth holds the number theory puzzles keep needing: gcd and the
+// extended Euclidean algorithm, modular arithmetic that cannot overflow,
+// the Chinese remainder theorem for moduli that share factors, and linear
+// Diophantine equations.
+package numth
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Abs returns |a|. Abs(math.MinInt64) overflows and returns it unchanged.
+func Abs(a int64) int64 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// GCD returns the greatest common divisor of a and b, which is never
+// negative. GCD(0, 0) is 0.
+func GCD(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// LCM returns the least common multiple of a and b, and false if it
+// overflows. LCM(0, b) is 0.
+func LCM(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	return MulChecked(Abs(a)/GCD(a, b), Abs(b))
+}
+
+// ExtGCD returns g = GCD(a, b) and Bézout coefficients with a*x + b*y = g.
+func ExtGCD(a, b int64) (g, x, y int64) {
+	x0, x1, y0, y1 := int64(1), int64(0), int64(0), int64(1)
+	for b != 0 {
+		q := a / b
+		a, b = b, a-q*b
+		x0, x1 = x1, x0-q*x1
+		y0, y1 = y1, y0-q*y1
+	}
+	if a < 0 {
+		return -a, -x0, -y0
+	}
+	return a, x0, y0
+}
+
+// MulChecked returns a*b, and false if it overflows.
+func MulChecked(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return p, true
+}
+
+// AddChecked returns a+b, and false if it overflows.
+func AddChecked(a, b int64) (int64, bool) {
+	s := a + b
+	if (a > 0 && b > 0 && s < 0) || (a < 0 && b < 0 && s >= 0) {
+		return 0, false
+	}
+	return s, true
+}
+
+// Mod returns a modulo m in [0, m). m must be positive.
+func Mod(a, m int64) int64 {
+	r := a % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// AddMod returns a+b modulo m in [0, m) without overflowing, whatever the
+// size of the operands. m must be positive.
+func AddMod(a, b, m int64) int64 {
+	a, b = Mod(a, m), Mod(b, m)
+	if a >= m-b {
+		return a - (m - b)
+	}
+	return a + b
+}
+
+// MulMod returns a*b modulo m in [0, m) without overflowing, whatever the
+// size of the operands. m must be positive.
+func MulMod(a, b, m int64) int64 {
+	hi, lo := bits.Mul64(uint64(Mod(a, m)), uint64(Mod(b, m)))
+	return int64(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// PowMod returns base^exp modulo m in [0, m). exp must not be negative and m
+// must be positive.
+func PowMod(base, exp, m int64) int64 {
+	result := Mod(1, m)
+	base = Mod(base, m)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+	}
+	return result
+}
+
+// ModInverse returns x in [0, m) with a*x ≡ 1 (mod m), and false if a and m
+// are not coprime. m must be positive.
+func ModInverse(a, m int64) (int64, bool) {
+	g, x, _ := ExtGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, false
+	}
+	return Mod(x, m), true
+}
+
+// CRT combines x ≡ r1 (mod m1) and x ≡ r2 (mod m2) into x ≡ r (mod m), where
+// m is the least common multiple of the moduli, which need not be coprime.
+// It returns false if the congruences contradict each other or m overflows.
+// The moduli must be positive.
+func CRT(r1, m1, r2, m2 int64) (r, m int64, ok bool) {
+	r1, r2 = Mod(r1, m1), Mod(r2, m2)
+	g, p, _ := ExtGCD(m1, m2)
+	diff := r2 - r1
+	if diff%g != 0 {
+		return 0, 0, false
+	}
+	m, ok = LCM(m1, m2)
+	if !ok {
+		return 0, 0, false
+	}
+	// m1*p ≡ g (mod m2), so k = diff/g * p solves m1*k ≡ diff (mod m2).
+	step := m2 / g
+	k := MulMod(diff/g, p, step)
+	return AddMod(r1, MulMod(m1, k, m), m), m, true
+}
+
+// CRTAll combines x ≡ residues[i] (mod moduli[i]) for every i as CRT does.
+// With no congruences it returns 0 mod 1.
+func CRTAll(residues, moduli []int64) (r, m int64, ok bool) {
+	r, m = 0, 1
+	for i := range residues {
+		if r, m, ok = CRT(r, m, residues[i], moduli[i]); !ok {
+			return 0, 0, false
+		}
+	}
+	return r, m, true
+}
@@ -0,0 +1,143 @@
+package numth
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestExtGCD(t *testing.T) {
+	for _, tt := range [][2]int64{{240, 46}, {-240, 46}, {17, 0}, {0, -5}, {1, 1}, {35, 64}} {
+		g, x, y := ExtGCD(tt[0], tt[1])
+		if g != GCD(tt[0], tt[1]) || tt[0]*x+tt[1]*y != g || g < 0 {
+			t.Errorf("ExtGCD(%d, %d) = %d, %d, %d", tt[0], tt[1], g, x, y)
+		}
+	}
+	if l, ok := LCM(4, 6); !ok || l != 12 {
+		t.Errorf("LCM(4, 6) = %d, %v", l, ok)
+	}
+	if _, ok := LCM(math.MaxInt64, math.MaxInt64-1); ok {
+		t.Error("LCM overflow not detected")
+	}
+}
+
+func TestChecked(t *testing.T) {
+	for _, tt := range []struct {
+		a, b int64
+		ok   bool
+	}{
+		{3037000499, 3037000499, true},
+		{3037000500, 3037000500, false},
+		{-1, math.MinInt64, false},
+		{math.MinInt64, 1, true},
+		{-4, 5, true},
+	} {
+		p, ok := MulChecked(tt.a, tt.b)
+		if ok != tt.ok || ok && p != tt.a*tt.b {
+			t.Errorf("MulChecked(%d, %d) = %d, %v", tt.a, tt.b, p, ok)
+		}
+	}
+	if _, ok := AddChecked(math.MaxInt64, 1); ok {
+		t.Error("AddChecked overflow not detected")
+	}
+	if _, ok := AddChecked(math.MinInt64, -1); ok {
+		t.Error("AddChecked underflow not detected")
+	}
+}
+
+func TestModular(t *testing.T) {
+	const m = 1_000_000_007
+	for _, tt := range [][3]int64{{math.MaxInt64, math.MaxInt64, m}, {-7, math.MaxInt64 - 1, math.MaxInt64}, {123456789, 987654321, 1 << 62}} {
+		want := new(big.Int).Mul(big.NewInt(tt[0]), big.NewInt(tt[1]))
+		want.Mod(want, big.NewInt(tt[2]))
+		if got := MulMod(tt[0], tt[1], tt[2]); got != want.Int64() {
+			t.Errorf("MulMod(%d, %d, %d) = %d, want %v", tt[0], tt[1], tt[2], got, want)
+		}
+	}
+	for _, tt := range [][3]int64{{math.MaxInt64 - 1, math.MaxInt64 - 2, math.MaxInt64}, {-5, math.MinInt64, m}, {1 << 62, 1 << 62, 3 << 61}} {
+		want := new(big.Int).Add(big.NewInt(tt[0]), big.NewInt(tt[1]))
+		want.Mod(want, big.NewInt(tt[2]))
+		if got := AddMod(tt[0], tt[1], tt[2]); got != want.Int64() {
+			t.Errorf("AddMod(%d, %d, %d) = %d, want %v", tt[0], tt[1], tt[2], got, want)
+		}
+	}
+	if got := PowMod(2, m-1, m); got != 1 {
+		t.Errorf("2^(p-1) mod p = %d, want 1", got)
+	}
+	if got := PowMod(-3, 3, 7); got != 1 {
+		t.Errorf("(-3)^3 mod 7 = %d, want 1", got)
+	}
+	if x, ok := ModInverse(3, 11); !ok || x != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v", x, ok)
+	}
+	if _, ok := ModInverse(6, 9); ok {
+		t.Error("ModInverse(6, 9) exists")
+	}
+}
+
+func TestCRT(t *testing.T) {
+	for _, tt := range []struct {
+		r, m []int64
+		want int64
+		mod  int64
+		ok   bool
+	}{
+		{[]int64{2, 3, 2}, []int64{3, 5, 7}, 23, 105, true},
+		{[]int64{3, 7}, []int64{4, 6}, 7, 12, true},
+		{[]int64{1, 2}, []int64{4, 6}, 0, 0, false},
+		{[]int64{-1, 0}, []int64{101, 103}, 5150, 10403, true},
+		{nil, nil, 0, 1, true},
+	} {
+		r, m, ok := CRTAll(tt.r, tt.m)
+		if ok != tt.ok || ok && (r != tt.want || m != tt.mod) {
+			t.Errorf("CRTAll(%v, %v) = %d mod %d, %v; want %d mod %d", tt.r, tt.m, r, m, ok, tt.want, tt.mod)
+		}
+	}
+	// Moduli whose product overflows but whose lcm does not.
+	large := int64(1) << 40
+	if r, m, ok := CRT(5, large*3, 5+large, large*5); !ok || m != large*15 || r%(large*3) != 5 || r%(large*5) != 5+large {
+		t.Errorf("CRT with large moduli = %d mod %d, %v", r, m, ok)
+	}
+	// Moduli near 2^62 whose lcm is just below math.MaxInt64.
+	for _, m1 := range []int64{1<<62 - 57, 1<<62 - 1} {
+		for _, tt := range [][2]int64{{m1 - 1, 1}, {m1 - 2, 0}, {0, 1}} {
+			r, m, ok := CRT(tt[0], m1, tt[1], 2)
+			if !ok || m != 2*m1 || r < 0 || r >= m || r%m1 != tt[0] || r%2 != tt[1] {
+				t.Errorf("CRT(%d, %d, %d, 2) = %d mod %d, %v", tt[0], m1, tt[1], r, m, ok)
+			}
+		}
+	}
+}
+
+func TestLinear(t *testing.T) {
+	sol, ok := SolveLinear(6, -4, 10)
+	if !ok || sol.X != 1 || 6*sol.X-4*sol.Y != 10 {
+		t.Fatalf("SolveLinear(6, -4, 10) = %+v, %v", sol, ok)
+	}
+	if x, y := sol.X+3*sol.DX, sol.Y-3*sol.DY; 6*x-4*y != 10 {
+		t.Errorf("step of %+v leaves the line", sol)
+	}
+	if _, ok := SolveLinear(4, 6, 7); ok {
+		t.Error("4x + 6y = 7 has a solution")
+	}
+
+	for _, tt := range []struct {
+		a, b, c, costX, costY int64
+		x, y                  int64
+		ok                    bool
+	}{
+		{4, 1, 10, 3, 1, 2, 2, true}, // A is cheaper per unit: as many as possible
+		{2, 1, 7, 3, 1, 0, 7, true},  // B is cheaper: none of A
+		{6, 4, 22, 1, 1, 3, 1, true}, // (1, 4) or (3, 1); the second is cheaper
+		{6, 4, 2, 1, 1, 0, 0, false}, // the only integer solutions have a negative part
+		{4, 6, 7, 1, 1, 0, 0, false}, // no integer solutions
+		{0, 3, 9, 5, 1, 0, 3, true},  // x is free and costs
+		{4, 1, 10000000000000, 3, 1, 2500000000000, 0, true},
+	} {
+		x, y, ok := MinCostNonNeg(tt.a, tt.b, tt.c, tt.costX, tt.costY)
+		if ok != tt.ok || ok && (x != tt.x || y != tt.y) {
+			t.Errorf("MinCostNonNeg(%d, %d, %d, %d, %d) = %d, %d, %v; want %d, %d, %v",
+				tt.a, tt.b, tt.c, tt.costX, tt.costY, x, y, ok, tt.x, tt.y, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Package parse reads puzzle inputs strictly. Malformed input is reported as
+// an *Error naming the line, column and offending token instead of being
+// read as zero.
+//
+// All readers drop a UTF-8 byte order mark, carriage returns before line
+// feeds and the blank lines at the end of the input.
+package parse
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error is malformed input.
+type Error struct {
+	// File is the input file, when known. aoc.Day.LoadFile fills it in.
+	File string
+	// Line and Col are 1-based; Col is 0 when the error concerns the whole
+	// line.
+	Line, Col int
+	// Token is the offending text.
+	Token string
+	// Msg says what is wrong.
+	Msg string
+	// Err is the underlying cause, such as strconv.ErrRange, if any.
+	Err error
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.File + ":")
+	}
+	fmt.Fprintf(&b, "%d:", e.Line)
+	if e.Col > 0 {
+		fmt.Fprintf(&b, "%d:", e.Col)
+	}
+	b.WriteString(" " + e.Msg)
+	if e.Token != "" || e.Col > 0 {
+		fmt.Fprintf(&b, " %q", e.Token)
+	}
+	return b.String()
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// WithFile records path as the file of a parse error in err's chain that
+// does not name one yet, and returns err. Call it before wrapping err with
+// fmt.Errorf, which formats the message right away.
+func WithFile(err error, path string) error {
+	var e *Error
+	if errors.As(err, &e) && e.File == "" {
+		e.File = path
+	}
+	return err
+}
+
+// Line is one line of input.
+type Line struct {
+	// No is the 1-based line number.
+	No   int
+	Text string
+}
+
+// Errorf returns an error about the whole line.
+func (l Line) Errorf(format string, args ...any) *Error {
+	return &Error{Line: l.No, Token: l.Text, Msg: fmt.Sprintf(format, args...)}
+}
+
+// ErrorAt returns an error about token, which starts at byte offset off of
+// the line.
+func (l Line) ErrorAt(off int, token, msg string) *Error {
+	return &Error{Line: l.No, Col: off + 1, Token: token, Msg: msg}
+}
+
+var bom = []byte("\xef\xbb\xbf")
+
+// Lines reads every line of r.
+func Lines(r io.Reader) ([]Line, error) {
+	var lines []Line
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		text := scanner.Bytes()
+		if len(lines) == 0 {
+			text = bytes.TrimPrefix(text, bom)
+		}
+		text = bytes.TrimSuffix(text, []byte("\r"))
+		lines = append(lines, Line{len(lines) + 1, string(text)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1].Text) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines, nil
+}
+
+// Sections reads r and splits it into groups of lines separated by blank
+// lines. Runs of blank lines count as one separator.
+func Sections(r io.Reader) ([][]Line, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var sections [][]Line
+	var cur []Line
+	for _, l := range lines {
+		if strings.TrimSpace(l.Text) == "" {
+			if cur != nil {
+				sections = append(sections, cur)
+				cur = nil
+			}
+			continue
+		}
+		cur = append(cur, l)
+	}
+	if cur != nil {
+		sections = append(sections, cur)
+	}
+	return sections, nil
+}
+
+// Int parses token, found at byte offset off of the line, as a signed
+// decimal integer. Surrounding spaces are ignored.
+func (l Line) Int(off int, token string) (int, error) {
+	trimmed := strings.TrimLeft(token, " \t")
+	off += len(token) - len(trimmed)
+	trimmed = strings.TrimRight(trimmed, " \t")
+	if trimmed == "" {
+		return 0, l.ErrorAt(off, token, "missing number")
+	}
+	n, err := strconv.Atoi(trimmed)
+	if err != nil {
+		e := l.ErrorAt(off, trimmed, "invalid number")
+		if errors.Is(err, strconv.ErrRange) {
+			e.Msg, e.Err = "number out of range", strconv.ErrRange
+		}
+		return 0, e
+	}
+	return n, nil
+}
+
+// Ints parses the fields of the line separated by sep, or by runs of spaces
+// and tabs when sep is empty.
+func (l Line) Ints(sep string) ([]int, error) {
+	return l.IntsAt(0, sep)
+}
+
+// IntsAt is Ints for the rest of the line from byte offset off, such as the
+// list after a "Program:" label.
+func (l Line) IntsAt(off int, sep string) ([]int, error) {
+	var nums []int
+	for _, f := range (Line{l.No, l.Text[off:]}).fields(sep) {
+		n, err := l.Int(off+f.off, f.text)
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+// IntsN is Ints for a line that must hold exactly n numbers.
+func (l Line) IntsN(sep string, n int) ([]int, error) {
+	fields := l.fields(sep)
+	if len(fields) != n {
+		return nil, l.Errorf("want %d numbers, got %d", n, len(fields))
+	}
+	return l.Ints(sep)
+}
+
+// Pair parses a line of the form "<a><sep><b>", such as "47|53".
+func (l Line) Pair(sep string) (int, int, error) {
+	nums, err := l.IntsN(sep, 2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return nums[0], nums[1], nil
+}
+
+// Match matches re against the line and parses every capture group as a
+// signed integer. A line that does not match is an error.
+func (l Line) Match(re *regexp.Regexp) ([]int, error) {
+	m := re.FindStringSubmatchIndex(l.Text)
+	if m == nil {
+		return nil, l.Errorf("does not match %s", re)
+	}
+	nums := make([]int, 0, len(m)/2-1)
+	for i := 2; i < len(m); i += 2 {
+		if m[i] < 0 {
+			return nil, l.Errorf("group %d of %s did not match", i/2, re)
+		}
+		n, err := l.Int(m[i], l.Text[m[i]:m[i+1]])
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+var signedRE = regexp.MustCompile(`[-+]?\d+`)
+
+// SignedInts returns every signed integer in the line, ignoring the text
+// between them.
+func (l Line) SignedInts() ([]int, error) {
+	var nums []int
+	for _, m := range signedRE.FindAllStringIndex(l.Text, -1) {
+		n, err := l.Int(m[0], l.Text[m[0]:m[1]])
+		if err != nil {
+			return nil, err
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+type field struct {
+	off  int
+	text string
+}
+
+func (l Line) fields(sep string) []field {
+	var out []field
+	if sep == "" {
+		start := -1
+		for i := 0; i <= len(l.Text); i++ {
+			blank := i == len(l.Text) || l.Text[i] == ' ' || l.Text[i] == '\t'
+			if blank && start >= 0 {
+				out = append(out, field{start, l.Text[start:i]})
+				start = -1
+			} else if !blank && start < 0 {
+				start = i
+			}
+		}
+		return out
+	}
+	off := 0
+	for _, f := range strings.Split(l.Text, sep) {
+		out = append(out, field{off, f})
+		off += len(f) + len(sep)
+	}
+	return out
+}
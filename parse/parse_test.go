@@ -0,0 +1,82 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	sections, err := Sections(strings.NewReader("\xef\xbb\xbf47|53\r\n97|13\r\n\r\n\r\n75,47,61\r\n\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]Line{
+		{{1, "47|53"}, {2, "97|13"}},
+		{{5, "75,47,61"}},
+	}
+	if !reflect.DeepEqual(sections, want) {
+		t.Errorf("Sections = %v, want %v", sections, want)
+	}
+}
+
+func TestLineErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func() error
+		want string
+	}{
+		{"pair", func() error { _, _, err := Line{3, "47|5x"}.Pair("|"); return err }, `3:4: invalid number "5x"`},
+		{"pair fields", func() error { _, _, err := Line{4, "47|53|1"}.Pair("|"); return err }, `4: want 2 numbers, got 3 "47|53|1"`},
+		{"empty field", func() error { _, err := Line{2, "1,,3"}.Ints(","); return err }, `2:3: missing number ""`},
+		{"rest", func() error { _, err := Line{5, "Program: 0,x,5"}.IntsAt(8, ","); return err }, `5:12: invalid number "x"`},
+		{"spaces", func() error { _, err := Line{7, "3   x4"}.Ints(""); return err }, `7:5: invalid number "x4"`},
+		{"range", func() error { _, err := Line{1, "p=1,99999999999999999999"}.SignedInts(); return err }, `1:5: number out of range "99999999999999999999"`},
+		{"regexp", func() error {
+			_, err := Line{9, "Button A: X+94, Y34"}.Match(regexp.MustCompile(`X\+(\d+), Y\+(\d+)`))
+			return err
+		}, `9: does not match X\+(\d+), Y\+(\d+) "Button A: X+94, Y34"`},
+	}
+	for _, tt := range tests {
+		err := tt.run()
+		var pe *Error
+		if !errors.As(err, &pe) {
+			t.Errorf("%s: err = %v, want *Error", tt.name, err)
+			continue
+		}
+		if got := err.Error(); got != tt.want {
+			t.Errorf("%s: err = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	err := WithFile(Line{5, "a"}.ErrorAt(0, "a", "invalid number"), "2024/day05/input.txt")
+	if got := err.Error(); got != `2024/day05/input.txt:5:1: invalid number "a"` {
+		t.Errorf("WithFile: %s", got)
+	}
+	_, err = Line{1, "99999999999999999999"}.Ints("")
+	if !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("out of range error does not wrap strconv.ErrRange: %v", err)
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	if got, err := (Line{1, "p=0,4 v=3,-3"}).SignedInts(); err != nil || !reflect.DeepEqual(got, []int{0, 4, 3, -3}) {
+		t.Errorf("SignedInts = %v, %v", got, err)
+	}
+	if got, err := (Line{1, " 3   4 "}).Ints(""); err != nil || !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Errorf("Ints = %v, %v", got, err)
+	}
+	if got, err := (Line{1, "Program: 0,1,5"}).IntsAt(8, ","); err != nil || !reflect.DeepEqual(got, []int{0, 1, 5}) {
+		t.Errorf("IntsAt = %v, %v", got, err)
+	}
+	if got, err := (Line{1, "162, 817,812"}).IntsN(",", 3); err != nil || !reflect.DeepEqual(got, []int{162, 817, 812}) {
+		t.Errorf("IntsN = %v, %v", got, err)
+	}
+	re := regexp.MustCompile(`X=(-?\d+), Y=(-?\d+)`)
+	if got, err := (Line{1, "Prize: X=8400, Y=-5400"}).Match(re); err != nil || !reflect.DeepEqual(got, []int{8400, -5400}) {
+		t.Errorf("Match = %v, %v", got, err)
+	}
+}
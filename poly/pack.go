@@ -0,0 +1,217 @@
+package poly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+// Placed is one piece of a packing.
+type Placed struct {
+	// Shape is the index of the shape in the list given to Pack.
+	Shape int
+	// Cells are the cells the piece covers.
+	Cells []grid.Point
+}
+
+// Packing is an arrangement of pieces in a rectangle.
+type Packing struct {
+	Width, Height int
+	Pieces        []Placed
+}
+
+// Pack reports whether counts[i] copies of shapes[i], for every i, fit into
+// a width×height rectangle without overlapping, and returns one arrangement
+// if they do. Pieces may be rotated and flipped; cells may stay empty.
+//
+// Pack rules out a region whose area is too small and accepts one that has
+// room for a copy of the largest bounding box per piece without searching.
+// Anything else is decided by backtracking: the first uncovered cell in
+// reading order is either covered by the first cell of some orientation of
+// some remaining shape or left empty, and no more cells may be left empty
+// than the region has to spare.
+func Pack(width, height int, shapes []Shape, counts []int) (*Packing, bool) {
+	if len(counts) > len(shapes) {
+		panic(fmt.Sprintf("poly: %d counts for %d shapes", len(counts), len(shapes)))
+	}
+	need, pieces := 0, 0
+	for i, n := range counts {
+		if n > 0 && shapes[i].Size() == 0 {
+			panic(fmt.Sprintf("poly: shape %d has no cells", i))
+		}
+		need += n * shapes[i].Size()
+		pieces += n
+	}
+	if width < 0 || height < 0 || need > width*height {
+		return nil, false
+	}
+	if p, ok := packInBoxes(width, height, shapes, counts, pieces); ok {
+		return p, true
+	}
+
+	s := &search{
+		w:      width,
+		h:      height,
+		filled: make([]bool, width*height),
+		left:   append([]int(nil), counts...),
+		pieces: pieces,
+		slack:  width*height - need,
+	}
+	// Try big shapes first; they are the hardest to fit late.
+	s.order = make([]int, len(counts))
+	for i := range s.order {
+		s.order[i] = i
+	}
+	sort.SliceStable(s.order, func(a, b int) bool {
+		return shapes[s.order[a]].Size() > shapes[s.order[b]].Size()
+	})
+	s.orients = make([][][]grid.Point, len(counts))
+	for i := range counts {
+		for _, o := range shapes[i].Orientations() {
+			// Make the cells relative to the first, which lands on the
+			// cell being covered.
+			cells := o.Cells()
+			first := cells[0]
+			for j := range cells {
+				cells[j] = cells[j].Sub(first)
+			}
+			s.orients[i] = append(s.orients[i], cells)
+		}
+	}
+	if !s.run(0) {
+		return nil, false
+	}
+	return &Packing{width, height, s.placed}, true
+}
+
+// packInBoxes cuts the region into boxes that hold any shape as given and
+// puts one piece in each, if there are enough boxes.
+func packInBoxes(width, height int, shapes []Shape, counts []int, pieces int) (*Packing, bool) {
+	bh, bw := 1, 1
+	for i, n := range counts {
+		if n > 0 {
+			bh, bw = max(bh, shapes[i].Height()), max(bw, shapes[i].Width())
+		}
+	}
+	across := width / bw
+	if across == 0 || across*(height/bh) < pieces {
+		return nil, false
+	}
+	p := &Packing{Width: width, Height: height}
+	box := 0
+	for i, n := range counts {
+		for ; n > 0; n-- {
+			at := grid.Point{R: box / across * bh, C: box % across * bw}
+			cells := shapes[i].Cells()
+			for j := range cells {
+				cells[j] = cells[j].Add(at)
+			}
+			p.Pieces = append(p.Pieces, Placed{i, cells})
+			box++
+		}
+	}
+	return p, true
+}
+
+type search struct {
+	w, h    int
+	filled  []bool
+	order   []int
+	orients [][][]grid.Point
+	left    []int
+	pieces  int
+	slack   int
+	placed  []Placed
+}
+
+func (s *search) run(pos int) bool {
+	if s.pieces == 0 {
+		return true
+	}
+	for pos < len(s.filled) && s.filled[pos] {
+		pos++
+	}
+	if pos == len(s.filled) {
+		return false
+	}
+	at := grid.Point{R: pos / s.w, C: pos % s.w}
+	for _, i := range s.order {
+		if s.left[i] == 0 {
+			continue
+		}
+		for _, o := range s.orients[i] {
+			if !s.fits(at, o) {
+				continue
+			}
+			cells := s.set(at, o, true)
+			s.left[i]--
+			s.pieces--
+			s.placed = append(s.placed, Placed{i, cells})
+			if s.run(pos + 1) {
+				return true
+			}
+			s.placed = s.placed[:len(s.placed)-1]
+			s.pieces++
+			s.left[i]++
+			s.set(at, o, false)
+		}
+	}
+	if s.slack > 0 {
+		s.slack--
+		s.filled[pos] = true
+		if s.run(pos + 1) {
+			// The cell stays empty in the packing; only pieces are
+			// recorded.
+			return true
+		}
+		s.filled[pos] = false
+		s.slack++
+	}
+	return false
+}
+
+func (s *search) fits(at grid.Point, cells []grid.Point) bool {
+	for _, d := range cells {
+		p := at.Add(d)
+		if p.R < 0 || p.R >= s.h || p.C < 0 || p.C >= s.w || s.filled[p.R*s.w+p.C] {
+			return false
+		}
+	}
+	return true
+}
+
+// set marks the cells of a piece and returns where they are.
+func (s *search) set(at grid.Point, cells []grid.Point, filled bool) []grid.Point {
+	out := make([]grid.Point, len(cells))
+	for i, d := range cells {
+		p := at.Add(d)
+		s.filled[p.R*s.w+p.C] = filled
+		out[i] = p
+	}
+	return out
+}
+
+// pieceLetters label the pieces of a drawing, repeating when they run out.
+const pieceLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// String draws the packing with a letter per piece and '.' for empty
+// cells, one line per row.
+func (p *Packing) String() string {
+	rows := make([][]byte, p.Height)
+	for r := range rows {
+		rows[r] = []byte(strings.Repeat(".", p.Width))
+	}
+	for i, piece := range p.Pieces {
+		for _, c := range piece.Cells {
+			rows[c.R][c.C] = pieceLetters[i%len(pieceLetters)]
+		}
+	}
+	var b strings.Builder
+	for _, row := range rows {
+		b.Write(row)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
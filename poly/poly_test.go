@@ -0,0 +1,125 @@
+package poly
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func mustShape(t *testing.T, drawing string) Shape {
+	t.Helper()
+	s, err := ParseShape(strings.Split(drawing, "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestOrientations(t *testing.T) {
+	for _, tt := range []struct {
+		name, drawing string
+		want          int
+	}{
+		{"square", "##/##", 1},
+		{"I", "####", 2},
+		{"T", "###/.#.", 4},
+		{"S", ".##/##.", 4},
+		{"L", "#./#./##", 8},
+		{"example shape 0", "###/##./##.", 8},
+	} {
+		if got := len(mustShape(t, tt.drawing).Orientations()); got != tt.want {
+			t.Errorf("%s has %d orientations, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	l := mustShape(t, "#./#./##")
+	if got := l.RotateRight().String(); got != "###\n#.." {
+		t.Errorf("RotateRight = %q", got)
+	}
+	if got := l.Reflect().String(); got != ".#\n.#\n##" {
+		t.Errorf("Reflect = %q", got)
+	}
+	if !l.Canonical().Equal(mustShape(t, "###/..#").Canonical()) {
+		t.Error("an L and its turned mirror image have different canonical forms")
+	}
+	if l.Canonical().Equal(mustShape(t, ".##/##.").Canonical()) {
+		t.Error("an L and an S have the same canonical form")
+	}
+	if _, err := ParseShape([]string{"#x"}); err == nil {
+		t.Error("ParseShape accepted x")
+	}
+	if _, err := ParseShape([]string{"..", "."}); !errors.Is(err, ErrNoCells) {
+		t.Errorf("ParseShape of a blank drawing: %v", err)
+	}
+	if _, err := NewShape(nil); !errors.Is(err, ErrNoCells) {
+		t.Errorf("NewShape(nil): %v", err)
+	}
+}
+
+// checkPacking verifies that p places count copies of the shapes inside the
+// region without overlaps, each in some orientation.
+func checkPacking(t *testing.T, p *Packing, shapes []Shape, counts []int) {
+	t.Helper()
+	used := make(map[grid.Point]bool)
+	got := make([]int, len(shapes))
+	for _, piece := range p.Pieces {
+		got[piece.Shape]++
+		for _, c := range piece.Cells {
+			if c.R < 0 || c.R >= p.Height || c.C < 0 || c.C >= p.Width || used[c] {
+				t.Fatalf("piece cell %v is outside or overlaps:\n%v", c, p)
+			}
+			used[c] = true
+		}
+		placed, err := NewShape(piece.Cells)
+		if err != nil {
+			t.Fatal(err)
+		}
+		match := false
+		for _, o := range shapes[piece.Shape].Orientations() {
+			match = match || o.Equal(placed)
+		}
+		if !match {
+			t.Fatalf("piece %v is not an orientation of shape %d", placed, piece.Shape)
+		}
+	}
+	for i := range counts {
+		if got[i] != counts[i] {
+			t.Fatalf("packing has %d of shape %d, want %d", got[i], i, counts[i])
+		}
+	}
+}
+
+func TestPack(t *testing.T) {
+	l := mustShape(t, "#./#./##")
+	tee := mustShape(t, "###/.#.")
+	for _, tt := range []struct {
+		name          string
+		width, height int
+		shapes        []Shape
+		counts        []int
+		fits          bool
+	}{
+		{"two Ls make a 2x4", 4, 2, []Shape{l}, []int{2}, true},
+		{"four Ts make a 4x4", 4, 4, []Shape{tee}, []int{4}, true},
+		{"two Ts do not make a 2x4", 4, 2, []Shape{tee}, []int{2}, false},
+		{"too little area", 3, 3, []Shape{l}, []int{3}, false},
+		{"room to spare", 9, 9, []Shape{l, tee}, []int{3, 3}, true},
+		{"nothing to pack", 0, 0, []Shape{l}, nil, true},
+	} {
+		p, ok := Pack(tt.width, tt.height, tt.shapes, tt.counts)
+		if ok != tt.fits {
+			t.Errorf("%s: Pack = %v, want %v", tt.name, ok, tt.fits)
+			continue
+		}
+		if ok {
+			checkPacking(t, p, tt.shapes, tt.counts)
+		}
+	}
+
+	p, _ := Pack(4, 2, []Shape{l}, []int{2})
+	if got := p.String(); got != "AAAB\nABBB\n" {
+		t.Errorf("String() = %q", got)
+	}
+}
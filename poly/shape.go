@@ -0,0 +1,187 @@
+// Package poly packs polyominoes, shapes made of grid cells, into
+// rectangles. Pieces may be rotated and flipped, and cells may be left
+// empty.
+package poly
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+// Shape is a set of cells, shifted so that its topmost row and leftmost
+// column are 0 and sorted in reading order.
+type Shape struct {
+	cells []grid.Point
+}
+
+// ErrNoCells reports a shape without cells, which cannot be packed.
+var ErrNoCells = errors.New("shape has no cells")
+
+// NewShape returns the shape made of the given cells. Repeated cells count
+// once.
+func NewShape(cells []grid.Point) (Shape, error) {
+	if len(cells) == 0 {
+		return Shape{}, ErrNoCells
+	}
+	return normalize(cells), nil
+}
+
+// normalize shifts cells to the top left corner, drops repeats and sorts
+// them in reading order.
+func normalize(cells []grid.Point) Shape {
+	if len(cells) == 0 {
+		return Shape{}
+	}
+	minR, minC := cells[0].R, cells[0].C
+	for _, p := range cells {
+		minR, minC = min(minR, p.R), min(minC, p.C)
+	}
+	seen := make(map[grid.Point]bool, len(cells))
+	var norm []grid.Point
+	for _, p := range cells {
+		q := grid.Point{R: p.R - minR, C: p.C - minC}
+		if !seen[q] {
+			seen[q] = true
+			norm = append(norm, q)
+		}
+	}
+	sort.Slice(norm, func(i, j int) bool {
+		if norm[i].R != norm[j].R {
+			return norm[i].R < norm[j].R
+		}
+		return norm[i].C < norm[j].C
+	})
+	return Shape{norm}
+}
+
+// ParseShape reads a shape drawn with '#' for its cells and '.' for gaps.
+func ParseShape(rows []string) (Shape, error) {
+	var cells []grid.Point
+	for r, row := range rows {
+		for c := 0; c < len(row); c++ {
+			switch row[c] {
+			case '#':
+				cells = append(cells, grid.Point{R: r, C: c})
+			case '.':
+			default:
+				return Shape{}, fmt.Errorf("row %d: invalid cell %q", r+1, row[c])
+			}
+		}
+	}
+	return NewShape(cells)
+}
+
+// Cells returns the cells of the shape in reading order.
+func (s Shape) Cells() []grid.Point {
+	return append([]grid.Point(nil), s.cells...)
+}
+
+// Size returns the number of cells.
+func (s Shape) Size() int {
+	return len(s.cells)
+}
+
+// Height returns the number of rows the shape spans.
+func (s Shape) Height() int {
+	h := 0
+	for _, p := range s.cells {
+		h = max(h, p.R+1)
+	}
+	return h
+}
+
+// Width returns the number of columns the shape spans.
+func (s Shape) Width() int {
+	w := 0
+	for _, p := range s.cells {
+		w = max(w, p.C+1)
+	}
+	return w
+}
+
+func (s Shape) transform(f func(grid.Point) grid.Point) Shape {
+	cells := make([]grid.Point, len(s.cells))
+	for i, p := range s.cells {
+		cells[i] = f(p)
+	}
+	return normalize(cells)
+}
+
+// RotateRight returns the shape turned a quarter clockwise.
+func (s Shape) RotateRight() Shape {
+	return s.transform(grid.Point.TurnRight)
+}
+
+// Reflect returns the mirror image of the shape, flipped left to right.
+func (s Shape) Reflect() Shape {
+	return s.transform(func(p grid.Point) grid.Point { return grid.Point{R: p.R, C: -p.C} })
+}
+
+// Orientations returns the distinct shapes the rotations and reflections of
+// s give: the rotations first, then those of the mirror image.
+func (s Shape) Orientations() []Shape {
+	var out []Shape
+	seen := make(map[string]bool)
+	for _, base := range []Shape{s, s.Reflect()} {
+		o := base
+		for i := 0; i < 4; i++ {
+			if k := o.String(); !seen[k] {
+				seen[k] = true
+				out = append(out, o)
+			}
+			o = o.RotateRight()
+		}
+	}
+	return out
+}
+
+// Canonical returns the orientation of s whose drawing sorts first, so two
+// shapes are the same free polyomino exactly when their canonical forms are
+// equal.
+func (s Shape) Canonical() Shape {
+	best := s
+	for _, o := range s.Orientations() {
+		if o.String() < best.String() {
+			best = o
+		}
+	}
+	return best
+}
+
+// Equal reports whether the shapes have the same cells in the same
+// orientation.
+func (s Shape) Equal(t Shape) bool {
+	if len(s.cells) != len(t.cells) {
+		return false
+	}
+	for i := range s.cells {
+		if s.cells[i] != t.cells[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String draws the shape with '#' and '.', one line per row.
+func (s Shape) String() string {
+	h, w := s.Height(), s.Width()
+	rows := make([][]byte, h)
+	for r := range rows {
+		rows[r] = []byte(strings.Repeat(".", w))
+	}
+	for _, p := range s.cells {
+		rows[p.R][p.C] = '#'
+	}
+	var b strings.Builder
+	for i, row := range rows {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.Write(row)
+	}
+	return b.String()
+}
@@ -0,0 +1,214 @@
+// Package scaffold creates the package for a new puzzle day and registers it
+// with the aoc command.
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/aoc"
+)
+
+// DaysFile is the file of the aoc command that imports every day package,
+// relative to the repository root.
+var DaysFile = filepath.Join("cmd", "aoc", "days.go")
+
+// Create writes the solution and example test of year and day below the
+// repository root and adds the package to DaysFile. It refuses to touch a
+// day that already has Go files. It returns the files it wrote.
+func Create(root string, year, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %d", day)
+	}
+	module, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(root, aoc.Dir(year, day))
+	existing, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("%s already has Go files, not overwriting", aoc.Dir(year, day))
+	}
+
+	data := struct {
+		Module, Package string
+		Year, Day       int
+		Input           string
+	}{module, filepath.Base(dir), year, day, aoc.InputPath(year, day)}
+	prefix, err := filePrefix(root, year)
+	if err != nil {
+		return nil, err
+	}
+	files := []struct {
+		name string
+		tmpl *template.Template
+	}{
+		{fmt.Sprintf("%s%d.go", prefix, day), solutionTmpl},
+		{fmt.Sprintf("%s%d_test.go", prefix, day), testTmpl},
+	}
+
+	_, err = os.Stat(dir)
+	madeDir := errors.Is(err, fs.ErrNotExist)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	// On failure remove what was written, so that a retry starts afresh.
+	var created []string
+	done := false
+	defer func() {
+		if done {
+			return
+		}
+		for _, path := range created {
+			os.Remove(path)
+		}
+		if madeDir {
+			os.Remove(dir)
+		}
+	}()
+	for _, f := range files {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.name, err)
+		}
+		path := filepath.Join(dir, f.name)
+		if err := writeNew(path, src); err != nil {
+			return nil, err
+		}
+		created = append(created, path)
+	}
+
+	daysFile := filepath.Join(root, DaysFile)
+	if err := register(daysFile, module, year, day); err != nil {
+		return nil, err
+	}
+	done = true
+	return append(created, daysFile), nil
+}
+
+// solutionFileRE matches the solution file of a day, such as AOC7.go or
+// Aoc12.go, capturing the prefix.
+var solutionFileRE = regexp.MustCompile(`^([A-Za-z]+)\d+\.go$`)
+
+// filePrefix returns the prefix the year's existing days use for their file
+// names, as the years differ: 2024 has AOC7.go, 2025 Aoc7.go. A year with
+// no days yet gets "Aoc".
+func filePrefix(root string, year int) (string, error) {
+	paths, err := filepath.Glob(filepath.Join(root, fmt.Sprint(year), "day*", "*.go"))
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		if m := solutionFileRE.FindStringSubmatch(filepath.Base(p)); m != nil {
+			return m[1], nil
+		}
+	}
+	return "Aoc", nil
+}
+
+// writeNew writes a file that must not exist yet.
+func writeNew(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, fs.ErrExist) {
+		return fmt.Errorf("%s already exists, not overwriting", path)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func modulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module line", goMod)
+}
+
+var (
+	importBlockRE = regexp.MustCompile(`(?s)import \((.*?)\n\)`)
+	dayImportRE   = regexp.MustCompile(`/(\d+)/day\d+"$`)
+)
+
+// register adds the blank import of the day package to the days file,
+// keeping the imports sorted and grouped by year.
+func register(path, module string, year, day int) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	m := importBlockRE.FindSubmatchIndex(src)
+	if m == nil {
+		return fmt.Errorf("%s: no import block", path)
+	}
+	pkg := fmt.Sprintf("%s/%s", module, filepath.ToSlash(aoc.Dir(year, day)))
+	var imports []string
+	for _, line := range strings.Split(string(src[m[2]:m[3]]), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if line == fmt.Sprintf("_ %q", pkg) {
+			return fmt.Errorf("%s already imports %s", path, pkg)
+		}
+		imports = append(imports, line)
+	}
+	imports = append(imports, fmt.Sprintf("_ %q", pkg))
+	sort.Strings(imports)
+
+	var block strings.Builder
+	prevYear := ""
+	for _, imp := range imports {
+		year := ""
+		if m := dayImportRE.FindStringSubmatch(imp); m != nil {
+			year = m[1]
+		}
+		if prevYear != "" && year != prevYear {
+			block.WriteString("\n")
+		}
+		prevYear = year
+		block.WriteString("\n\t" + imp)
+	}
+
+	out := append([]byte(nil), src[:m[2]]...)
+	out = append(out, block.String()...)
+	out = append(out, src[m[3]:]...)
+	out, err = format.Source(out)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, out, 0o644)
+}
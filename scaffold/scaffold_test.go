@@ -0,0 +1,105 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const daysSrc = `package main
+
+// Every solution registers itself with the aoc package from its init
+// function, so importing the day packages is all the runner needs.
+import (
+	_ "example.com/aoc/2024/day01"
+
+	_ "example.com/aoc/2025/day01"
+	_ "example.com/aoc/2025/day03"
+)
+`
+
+func TestCreate(t *testing.T) {
+	root := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", "module example.com/aoc\n\ngo 1.22\n")
+	write(DaysFile, daysSrc)
+	write("2025/day02/input.txt", "1\n")
+
+	files, err := Create(root, 2025, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("Create wrote %v", files)
+	}
+	src, err := os.ReadFile(filepath.Join(root, "2025/day02/Aoc2.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"package day02", `"example.com/aoc/aoc"`, "Year: 2025, Day: 2,", "parse.Lines(r)"} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("Aoc2.go does not contain %q", want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "2025/day02/Aoc2_test.go")); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := Create(root, 2026, 1); err != nil {
+		t.Fatal(err)
+	}
+	days, _ := os.ReadFile(filepath.Join(root, DaysFile))
+	want := strings.Replace(daysSrc, `	_ "example.com/aoc/2025/day03"
+`, `	_ "example.com/aoc/2025/day02"
+	_ "example.com/aoc/2025/day03"
+
+	_ "example.com/aoc/2026/day01"
+`, 1)
+	if string(days) != want {
+		t.Errorf("days file:\n%s\nwant:\n%s", days, want)
+	}
+
+	// New days follow the file names of their year.
+	write("2024/day01/AOC1.go", "package day01\n")
+	write("2024/day01/AOC1_test.go", "package day01\n")
+	if _, err := Create(root, 2024, 3); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"2024/day03/AOC3.go", "2024/day03/AOC3_test.go"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if _, err := Create(root, 2025, 2); err == nil || !strings.Contains(err.Error(), "not overwriting") {
+		t.Errorf("second Create: err = %v, want refusal", err)
+	}
+
+	// A days file that cannot be updated leaves no day files behind.
+	write(DaysFile, "package main\n")
+	if files, err := Create(root, 2025, 4); err == nil || files != nil {
+		t.Errorf("Create with a broken days file = %v, %v", files, err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "2025/day04")); !os.IsNotExist(err) {
+		t.Errorf("failed Create left 2025/day04 behind: %v", err)
+	}
+	write("2025/day05/input.txt", "1\n")
+	if _, err := Create(root, 2025, 5); err == nil {
+		t.Error("Create with a broken days file succeeded")
+	}
+	if gofiles, _ := filepath.Glob(filepath.Join(root, "2025/day05/*.go")); len(gofiles) > 0 {
+		t.Errorf("failed Create left %v behind", gofiles)
+	}
+	if _, err := os.Stat(filepath.Join(root, "2025/day05/input.txt")); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,66 @@
+package scaffold
+
+import "text/template"
+
+var solutionTmpl = template.Must(template.New("solution").Parse(`package {{.Package}}
+
+import (
+	"io"
+
+	"{{.Module}}/aoc"
+	"{{.Module}}/parse"
+)
+
+// Day {{.Day}} of Advent of Code {{.Year}}. The input is read from {{.Input}};
+// download it with "aoc fetch {{.Year}} {{.Day}}".
+
+func init() {
+	aoc.Register(aoc.Day{Year: {{.Year}}, Day: {{.Day}}, New: func() aoc.Solver { return new(solver) }})
+}
+
+type solver struct {
+	lines []parse.Line
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return aoc.ErrEmptyInput
+	}
+	s.lines = lines
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNotImplemented
+}
+`))
+
+var testTmpl = template.Must(template.New("test").Parse(`package {{.Package}}
+
+import (
+	"testing"
+
+	"{{.Module}}/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, {{.Year}}, {{.Day}}, []aoctest.Case{
+		{
+			Name: "example",
+			Input: ` + "`" + `
+` + "`" + `,
+			Part1: "",
+			Part2: "",
+			Skip:  "example not filled in yet",
+		},
+	})
+}
+`))
@@ -0,0 +1,193 @@
+// Package search finds shortest paths over implicit graphs. A state is any
+// comparable value, such as a grid.Point or a struct of position and
+// heading; the caller supplies the moves out of a state and the search
+// discovers the rest.
+package search
+
+import "container/heap"
+
+// Edge is a move to another state at some cost. Costs must not be negative.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// Options control a search.
+type Options[S comparable] struct {
+	// Goal stops the search at the first goal state to be settled. With no
+	// Goal every reachable state is settled.
+	Goal func(S) bool
+
+	// AllPredecessors keeps every predecessor that reaches a state at its
+	// optimal cost, not just the first one found. Result.OnBestPaths needs
+	// it.
+	AllPredecessors bool
+}
+
+// Result is the outcome of a search.
+type Result[S comparable] struct {
+	// Dist is the cost of the cheapest path from the start to each settled
+	// state, and an upper bound for states that were reached but not
+	// settled when a goal stopped the search. Under an inconsistent A*
+	// heuristic only the goal counts as settled.
+	Dist map[S]int
+
+	// Goal is the goal state the search stopped at; Found reports whether
+	// there was one.
+	Goal  S
+	Found bool
+
+	start S
+	prev  map[S][]S
+}
+
+// BFS searches a graph whose moves all cost 1.
+func BFS[S comparable](start S, next func(S) []S, opts Options[S]) *Result[S] {
+	res := newResult(start)
+	queue := []S{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if opts.Goal != nil && opts.Goal(cur) {
+			res.Goal, res.Found = cur, true
+			return res
+		}
+		d := res.Dist[cur] + 1
+		for _, to := range next(cur) {
+			old, seen := res.Dist[to]
+			switch {
+			case !seen:
+				res.Dist[to] = d
+				res.prev[to] = []S{cur}
+				queue = append(queue, to)
+			case old == d && opts.AllPredecessors:
+				res.prev[to] = append(res.prev[to], cur)
+			}
+		}
+	}
+	return res
+}
+
+// Dijkstra searches a graph with weighted moves.
+func Dijkstra[S comparable](start S, next func(S) []Edge[S], opts Options[S]) *Result[S] {
+	return AStar(start, next, nil, opts)
+}
+
+// AStar searches a graph with weighted moves, expanding states in order of
+// their cost so far plus the heuristic h, which estimates the remaining cost
+// to a goal. h must never overestimate. A state reached again more cheaply
+// after it was expanded is expanded again, so distances stay exact even if h
+// is not consistent; a consistent h, with h(a) <= cost(a, b) + h(b) for every
+// move, expands each state once. A nil h makes the search Dijkstra's.
+func AStar[S comparable](start S, next func(S) []Edge[S], h func(S) int, opts Options[S]) *Result[S] {
+	if h == nil {
+		h = func(S) int { return 0 }
+	}
+	res := newResult(start)
+	pq := &queue[S]{{start, h(start), 0}}
+	for pq.Len() > 0 {
+		it := heap.Pop(pq).(item[S])
+		if it.dist > res.Dist[it.state] {
+			continue
+		}
+		if opts.Goal != nil && opts.Goal(it.state) {
+			res.Goal, res.Found = it.state, true
+			return res
+		}
+		for _, e := range next(it.state) {
+			d := it.dist + e.Cost
+			old, seen := res.Dist[e.To]
+			switch {
+			case !seen || d < old:
+				res.Dist[e.To] = d
+				res.prev[e.To] = append(res.prev[e.To][:0], it.state)
+				heap.Push(pq, item[S]{e.To, d + h(e.To), d})
+			case d == old && opts.AllPredecessors && e.To != it.state:
+				res.prev[e.To] = append(res.prev[e.To], it.state)
+			}
+		}
+	}
+	return res
+}
+
+func newResult[S comparable](start S) *Result[S] {
+	return &Result[S]{
+		Dist:  map[S]int{start: 0},
+		start: start,
+		prev:  make(map[S][]S),
+	}
+}
+
+// Reached reports whether the search found a path to s.
+func (r *Result[S]) Reached(s S) bool {
+	_, ok := r.Dist[s]
+	return ok
+}
+
+// Predecessors returns the states a cheapest path to s comes from: the first
+// one found, or all of them when the search kept AllPredecessors.
+func (r *Result[S]) Predecessors(s S) []S {
+	return r.prev[s]
+}
+
+// Path returns a cheapest path from the start to s, both included, or nil if
+// s was not reached.
+func (r *Result[S]) Path(s S) []S {
+	if !r.Reached(s) {
+		return nil
+	}
+	path := []S{s}
+	for s != r.start {
+		s = r.prev[s][0]
+		path = append(path, s)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// OnBestPaths returns every state on some cheapest path from the start to
+// any of ends. Ends that were not reached are ignored; the caller picks the
+// ends, so it decides whether ties between them count. The result is only
+// complete if the search kept AllPredecessors.
+func (r *Result[S]) OnBestPaths(ends ...S) map[S]bool {
+	on := make(map[S]bool)
+	var stack []S
+	for _, e := range ends {
+		if r.Reached(e) && !on[e] {
+			on[e] = true
+			stack = append(stack, e)
+		}
+	}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range r.prev[s] {
+			if !on[p] {
+				on[p] = true
+				stack = append(stack, p)
+			}
+		}
+	}
+	return on
+}
+
+type item[S any] struct {
+	state     S
+	pri, dist int
+}
+
+// queue is a min-heap of states by priority.
+type queue[S any] []item[S]
+
+func (q queue[S]) Len() int           { return len(q) }
+func (q queue[S]) Less(i, j int) bool { return q[i].pri < q[j].pri }
+func (q queue[S]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[S]) Push(x any)        { *q = append(*q, x.(item[S])) }
+func (q *queue[S]) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+// diamond has two cheapest routes from a to d and a dearer one.
+var diamond = map[string][]Edge[string]{
+	"a": {{"b", 1}, {"c", 2}, {"e", 1}},
+	"b": {{"d", 2}},
+	"c": {{"d", 1}},
+	"e": {{"d", 5}},
+}
+
+func diamondNext(s string) []Edge[string] { return diamond[s] }
+
+func TestDijkstra(t *testing.T) {
+	res := Dijkstra("a", diamondNext, Options[string]{})
+	if got := res.Dist["d"]; got != 3 {
+		t.Errorf("Dist[d] = %d, want 3", got)
+	}
+	if got := res.Path("d"); len(got) != 3 || got[0] != "a" || got[2] != "d" {
+		t.Errorf("Path(d) = %v", got)
+	}
+	if res.Path("x") != nil {
+		t.Error("Path to an unreached state is not nil")
+	}
+
+	res = Dijkstra("a", diamondNext, Options[string]{AllPredecessors: true})
+	want := map[string]bool{"a": true, "b": true, "c": true, "d": true}
+	if got := res.OnBestPaths("d"); !reflect.DeepEqual(got, want) {
+		t.Errorf("OnBestPaths(d) = %v, want %v", got, want)
+	}
+}
+
+func TestGoal(t *testing.T) {
+	res := Dijkstra("a", diamondNext, Options[string]{Goal: func(s string) bool { return s == "b" }})
+	if !res.Found || res.Goal != "b" || res.Dist["b"] != 1 {
+		t.Errorf("Goal = %q, %v, dist %d", res.Goal, res.Found, res.Dist["b"])
+	}
+	res = Dijkstra("a", diamondNext, Options[string]{Goal: func(s string) bool { return s == "x" }})
+	if res.Found {
+		t.Error("found an unreachable goal")
+	}
+}
+
+type point struct{ x, y int }
+
+// open4 moves on an open 5x5 board except through the wall at x == 2,
+// which has a gap at y == 4.
+func open4(p point) []point {
+	var next []point
+	for _, d := range []point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+		q := point{p.x + d.x, p.y + d.y}
+		if q.x < 0 || q.y < 0 || q.x > 4 || q.y > 4 || q.x == 2 && q.y != 4 {
+			continue
+		}
+		next = append(next, q)
+	}
+	return next
+}
+
+func TestBFSAndAStar(t *testing.T) {
+	start, goal := point{0, 0}, point{4, 0}
+	bfs := BFS(start, open4, Options[point]{})
+	if got := bfs.Dist[goal]; got != 12 {
+		t.Fatalf("BFS Dist = %d, want 12", got)
+	}
+	if got := len(bfs.Path(goal)); got != 13 {
+		t.Errorf("BFS path has %d states, want 13", got)
+	}
+
+	weighted := func(p point) []Edge[point] {
+		var edges []Edge[point]
+		for _, q := range open4(p) {
+			edges = append(edges, Edge[point]{q, 1})
+		}
+		return edges
+	}
+	h := func(p point) int { return abs(goal.x-p.x) + abs(goal.y-p.y) }
+	astar := AStar(start, weighted, h, Options[point]{Goal: func(p point) bool { return p == goal }})
+	if !astar.Found || astar.Dist[goal] != 12 {
+		t.Errorf("AStar Dist = %d, %v, want 12", astar.Dist[goal], astar.Found)
+	}
+
+	all := BFS(start, open4, Options[point]{AllPredecessors: true})
+	on := all.OnBestPaths(point{1, 1})
+	if len(on) != 4 {
+		t.Errorf("OnBestPaths((1,1)) = %v, want the whole 2x2 square", on)
+	}
+}
+
+func TestAStarInconsistent(t *testing.T) {
+	// h(b) = 7 never overestimates b's cost of 11 to the goal, but exceeds
+	// cost(b, c) + h(c) = 1. c is expanded first by the dearer way round a,
+	// and must be expanded again once b reaches it more cheaply.
+	edges := map[string][]Edge[string]{
+		"s": {{"a", 1}, {"b", 3}},
+		"a": {{"c", 5}},
+		"b": {{"c", 1}},
+		"c": {{"g", 10}},
+	}
+	next := func(s string) []Edge[string] { return edges[s] }
+	h := func(s string) int {
+		if s == "b" {
+			return 7
+		}
+		return 0
+	}
+	res := AStar("s", next, h, Options[string]{Goal: func(s string) bool { return s == "g" }})
+	if !res.Found || res.Dist["g"] != 14 {
+		t.Errorf("AStar Dist[g] = %d, %v, want 14", res.Dist["g"], res.Found)
+	}
+	if got := res.Path("g"); !reflect.DeepEqual(got, []string{"s", "b", "c", "g"}) {
+		t.Errorf("Path(g) = %v", got)
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
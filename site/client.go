@@ -0,0 +1,108 @@
+// Package site talks to the Advent of Code website: it downloads puzzle
+// inputs into the canonical location of each day and submits answers,
+// keeping a local history of every guess.
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies this tool to the site, as its operator
+	// asks automated clients to do.
+	DefaultUserAgent = "github.com/shubhamsugara22/AdventOfCode-202X aoc tool"
+)
+
+var (
+	// ErrUnauthorized is returned when the site rejects the session token.
+	ErrUnauthorized = errors.New("session token rejected; log in again and update it")
+	// ErrNotAvailable is returned for puzzles that are not unlocked yet.
+	ErrNotAvailable = errors.New("puzzle not available yet")
+)
+
+// Doer sends HTTP requests. *http.Client implements it; tests can plug in
+// their own.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client is an Advent of Code client for one session.
+type Client struct {
+	// BaseURL is the site root, DefaultBaseURL when empty.
+	BaseURL string
+	// Session is the value of the "session" cookie of a logged in browser.
+	Session string
+	// UserAgent is sent with every request, DefaultUserAgent when empty.
+	UserAgent string
+	// HTTP sends the requests, http.DefaultClient when nil.
+	HTTP Doer
+}
+
+// do sends a request for the puzzle page path below the base URL and returns
+// the response body of a successful request.
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, contentType string) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(base, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+	ua := c.UserAgent
+	if ua == "" {
+		ua = DefaultUserAgent
+	}
+	req.Header.Set("User-Agent", ua)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	doer := c.HTTP
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return data, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s %s: %w", method, path, ErrNotAvailable)
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized ||
+		strings.Contains(string(data), "log in"):
+		return nil, fmt.Errorf("%s %s: %w", method, path, ErrUnauthorized)
+	}
+	return nil, fmt.Errorf("%s %s: %s", method, path, resp.Status)
+}
+
+// Input downloads the puzzle input of year and day.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	data, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%d day %d: empty input", year, day)
+	}
+	return data, nil
+}
@@ -0,0 +1,152 @@
+package site
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultHistory is the guess history file at the repository root. It
+// records personal guesses, so .gitignore keeps it out of commits.
+const DefaultHistory = "guesses.txt"
+
+// ErrRefused is returned by History.Check for answers that cannot be right.
+var ErrRefused = errors.New("answer refused")
+
+// Guess is one submitted answer.
+type Guess struct {
+	Time            time.Time
+	Year, Day, Part int
+	Outcome         Outcome
+	Answer          string
+}
+
+// History lists every submitted answer in submission order. Its file holds
+// one guess per line:
+//
+//	# time year day part outcome answer
+//	2025-12-08T06:01:13Z 2025 8 1 too-low 4224
+type History []Guess
+
+// ParseHistory reads a history file.
+func ParseHistory(r io.Reader) (History, error) {
+	var h History
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, " ", 6)
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("line %d: want \"<time> <year> <day> <part> <outcome> <answer>\", got %q", lineNo, line)
+		}
+		t, err := time.Parse(time.RFC3339, fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		var nums [3]int
+		for i := range nums {
+			n, err := strconv.Atoi(fields[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid number %q", lineNo, fields[i+1])
+			}
+			nums[i] = n
+		}
+		outcome, ok := parseOutcome(fields[4])
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid outcome %q", lineNo, fields[4])
+		}
+		h = append(h, Guess{t, nums[0], nums[1], nums[2], outcome, fields[5]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// LoadHistory reads the history file at path. A missing file is an empty
+// history.
+func LoadHistory(path string) (History, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	h, err := ParseHistory(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return h, nil
+}
+
+// AppendHistory adds g to the history file at path, creating it if needed.
+func AppendHistory(path string, g Guess) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if info, err := f.Stat(); err == nil && info.Size() == 0 {
+		fmt.Fprintln(f, "# time year day part outcome answer")
+	}
+	_, err = fmt.Fprintf(f, "%s %d %d %d %v %s\n",
+		g.Time.UTC().Format(time.RFC3339), g.Year, g.Day, g.Part, g.Outcome, g.Answer)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Check refuses an answer that the history already rules out: the part is
+// solved, the same answer was rejected before, or a numeric answer lies
+// outside the bounds set by earlier too-high and too-low replies.
+func (h History) Check(year, day, part int, answer string) error {
+	n, numeric := new(big.Int).SetString(answer, 10)
+	var low, high *big.Int // largest too-low and smallest too-high guess
+	for _, g := range h {
+		if g.Year != year || g.Day != day || g.Part != part {
+			continue
+		}
+		switch g.Outcome {
+		case Correct:
+			return fmt.Errorf("%w: part already solved with %s", ErrRefused, g.Answer)
+		case Wrong, TooHigh, TooLow:
+			if g.Answer == answer {
+				return fmt.Errorf("%w: %s was already rejected as %v", ErrRefused, answer, g.Outcome)
+			}
+		}
+		m, ok := new(big.Int).SetString(g.Answer, 10)
+		if !ok {
+			continue
+		}
+		if g.Outcome == TooLow && (low == nil || m.Cmp(low) > 0) {
+			low = m
+		}
+		if g.Outcome == TooHigh && (high == nil || m.Cmp(high) < 0) {
+			high = m
+		}
+	}
+	if !numeric {
+		return nil
+	}
+	if low != nil && n.Cmp(low) <= 0 {
+		return fmt.Errorf("%w: %s is not above %v, which was too low", ErrRefused, answer, low)
+	}
+	if high != nil && n.Cmp(high) >= 0 {
+		return fmt.Errorf("%w: %s is not below %v, which was too high", ErrRefused, answer, high)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package site
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SessionEnv is the environment variable holding the session token.
+const SessionEnv = "AOC_SESSION"
+
+// ErrNoSession is returned when no session token is configured.
+var ErrNoSession = errors.New("no session token: set " + SessionEnv + " or write it to aoc/session in the user config directory")
+
+// SessionFile returns the config file that holds the session token when
+// the environment variable is not set.
+func SessionFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc", "session"), nil
+}
+
+// LoadSession returns the session token from the environment or, failing
+// that, from SessionFile. A "session=" prefix, as copied from a cookie
+// header, is accepted.
+func LoadSession() (string, error) {
+	token := os.Getenv(SessionEnv)
+	if token == "" {
+		path, err := SessionFile()
+		if err != nil {
+			return "", ErrNoSession
+		}
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", ErrNoSession
+		}
+		if err != nil {
+			return "", err
+		}
+		token = string(data)
+	}
+	token = strings.TrimPrefix(strings.TrimSpace(token), "session=")
+	if token == "" || strings.ContainsAny(token, " \t\r\n;") {
+		return "", errors.New("malformed session token")
+	}
+	return token, nil
+}
@@ -0,0 +1,79 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFetch(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/2025/day/7/input" {
+			http.NotFound(w, r)
+			return
+		}
+		if ua := r.Header.Get("User-Agent"); ua != DefaultUserAgent {
+			t.Errorf("User-Agent = %q", ua)
+		}
+		if c, err := r.Cookie("session"); err != nil || c.Value != "abc123" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Puzzle inputs differ by user.  Please log in to get your puzzle input.\n"))
+			return
+		}
+		w.Write([]byte("..S..\n"))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := &Client{BaseURL: srv.URL, Session: "abc123"}
+	path := filepath.Join(t.TempDir(), "2025", "day07", "input.txt")
+
+	for i, wantDownload := range []bool{true, false} {
+		downloaded, err := Fetch(ctx, c, 2025, 7, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if downloaded != wantDownload {
+			t.Errorf("fetch %d: downloaded = %v, want %v", i+1, downloaded, wantDownload)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "..S..\n" {
+		t.Errorf("cached input = %q", data)
+	}
+
+	if _, err := c.Input(ctx, 2025, 13); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("unknown day: err = %v, want ErrNotAvailable", err)
+	}
+	bad := &Client{BaseURL: srv.URL, Session: "expired"}
+	if _, err := bad.Input(ctx, 2025, 7); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("bad session: err = %v, want ErrUnauthorized", err)
+	}
+	none := &Client{BaseURL: srv.URL}
+	if _, err := Fetch(ctx, none, 2025, 8, filepath.Join(t.TempDir(), "input.txt")); !errors.Is(err, ErrNoSession) {
+		t.Errorf("no session: err = %v, want ErrNoSession", err)
+	}
+}
+
+func TestLoadSession(t *testing.T) {
+	t.Setenv(SessionEnv, " session=53616c7465645f5f \n")
+	got, err := LoadSession()
+	if err != nil || got != "53616c7465645f5f" {
+		t.Errorf("LoadSession() = %q, %v", got, err)
+	}
+
+	t.Setenv(SessionEnv, "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	if _, err := LoadSession(); !errors.Is(err, ErrNoSession) {
+		t.Errorf("unset: err = %v, want ErrNoSession", err)
+	}
+}
@@ -0,0 +1,112 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's verdict on a submitted answer.
+type Outcome int
+
+const (
+	Unknown Outcome = iota
+	Correct
+	Wrong
+	TooHigh
+	TooLow
+	RateLimited
+	AlreadySolved
+)
+
+var outcomeNames = [...]string{"unknown", "correct", "wrong", "too-high", "too-low", "rate-limited", "already-solved"}
+
+func (o Outcome) String() string {
+	if o < 0 || int(o) >= len(outcomeNames) {
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+	return outcomeNames[o]
+}
+
+// parseOutcome is the inverse of Outcome.String.
+func parseOutcome(s string) (Outcome, bool) {
+	for i, name := range outcomeNames {
+		if name == s {
+			return Outcome(i), true
+		}
+	}
+	return Unknown, false
+}
+
+// Verdict is the parsed reply to a submission.
+type Verdict struct {
+	Outcome Outcome
+	// Wait is how long the site asks to wait before the next submission,
+	// when it says so.
+	Wait time.Duration
+	// Message is the text of the reply with the HTML stripped.
+	Message string
+}
+
+var (
+	articleRE = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRE     = regexp.MustCompile(`<[^>]*>`)
+	leftRE    = regexp.MustCompile(`You have ((?:\d+h ?)?(?:\d+m ?)?(?:\d+s)?) left to wait`)
+	waitRE    = regexp.MustCompile(`(?i)wait (one|\d+) minutes? before trying again`)
+)
+
+// ParseVerdict reads the site's reply page to an answer submission.
+func ParseVerdict(page string) Verdict {
+	msg := page
+	if m := articleRE.FindStringSubmatch(page); m != nil {
+		msg = m[1]
+	}
+	msg = strings.Join(strings.Fields(tagRE.ReplaceAllString(msg, "")), " ")
+	v := Verdict{Message: msg}
+
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		v.Outcome = Correct
+	case strings.Contains(msg, "You gave an answer too recently"):
+		v.Outcome = RateLimited
+	case strings.Contains(msg, "Did you already complete it"):
+		v.Outcome = AlreadySolved
+	case strings.Contains(msg, "your answer is too high"):
+		v.Outcome = TooHigh
+	case strings.Contains(msg, "your answer is too low"):
+		v.Outcome = TooLow
+	case strings.Contains(msg, "That's not the right answer"):
+		v.Outcome = Wrong
+	}
+
+	if m := leftRE.FindStringSubmatch(msg); m != nil {
+		v.Wait, _ = time.ParseDuration(strings.ReplaceAll(m[1], " ", ""))
+	} else if m := waitRE.FindStringSubmatch(msg); m != nil {
+		n := 1
+		if m[1] != "one" {
+			n, _ = strconv.Atoi(m[1])
+		}
+		v.Wait = time.Duration(n) * time.Minute
+	}
+	return v
+}
+
+// Submit sends answer for a part of a puzzle and parses the reply.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Verdict, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	page, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day),
+		strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	if err != nil {
+		return Verdict{}, err
+	}
+	v := ParseVerdict(string(page))
+	if v.Outcome == Unknown {
+		return v, fmt.Errorf("unrecognized reply: %q", v.Message)
+	}
+	return v, nil
+}
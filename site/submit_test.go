@@ -0,0 +1,107 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		page string
+		want Outcome
+		wait time.Duration
+	}{
+		{`<main><article><p>That's the right answer!  You are <em>one gold star</em> closer.</p></article></main>`, Correct, 0},
+		{`<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.</p></article>`, Wrong, time.Minute},
+		{`<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again. [<a href="/2025/day/8">Return to Day 8</a>]</p></article>`, TooHigh, time.Minute},
+		{`<article><p>That's not the right answer; your answer is too low.  Because you have guessed incorrectly 4 times on this puzzle, please wait 5 minutes before trying again.</p></article>`, TooLow, 5 * time.Minute},
+		{`<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 32s left to wait.</p></article>`, RateLimited, 4*time.Minute + 32*time.Second},
+		{`<article><p>You gave an answer too recently. You have 38s left to wait.</p></article>`, RateLimited, 38 * time.Second},
+		{`<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`, AlreadySolved, 0},
+		{`<html>Service unavailable</html>`, Unknown, 0},
+	}
+	for _, tt := range tests {
+		v := ParseVerdict(tt.page)
+		if v.Outcome != tt.want || v.Wait != tt.wait {
+			t.Errorf("ParseVerdict(%.50q) = %v, %v; want %v, %v", tt.page, v.Outcome, v.Wait, tt.want, tt.wait)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2025/day/8/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.FormValue("level") != "2" {
+			t.Errorf("level = %q", r.FormValue("level"))
+		}
+		if r.FormValue("answer") == "25325968" {
+			w.Write([]byte(`<article><p>That's the right answer!</p></article>`))
+			return
+		}
+		w.Write([]byte(`<article><p>That's not the right answer; your answer is too low.</p></article>`))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL, Session: "abc123"}
+	for answer, want := range map[string]Outcome{"25325968": Correct, "42": TooLow} {
+		v, err := c.Submit(context.Background(), 2025, 8, 2, answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Outcome != want {
+			t.Errorf("Submit(%s) = %v, want %v", answer, v.Outcome, want)
+		}
+	}
+}
+
+func TestHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "guesses.txt")
+	now := time.Date(2025, 12, 8, 6, 1, 13, 0, time.UTC)
+	for _, g := range []Guess{
+		{now, 2025, 8, 1, TooLow, "4224"},
+		{now, 2025, 8, 1, TooHigh, "90000"},
+		{now, 2025, 8, 1, RateLimited, "50000"},
+		{now, 2025, 8, 1, Wrong, "54179"},
+		{now, 2025, 8, 2, Correct, "25325968"},
+	} {
+		if err := AppendHistory(path, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 5 || h[0] != (Guess{now, 2025, 8, 1, TooLow, "4224"}) {
+		t.Fatalf("LoadHistory = %+v", h)
+	}
+
+	tests := []struct {
+		part   int
+		answer string
+		ok     bool
+	}{
+		{1, "54180", true},
+		{1, "50000", true}, // rate limited, never judged
+		{1, "54179", false},
+		{1, "4224", false},
+		{1, "100", false},
+		{1, "90001", false},
+		{1, "abc", true},
+		{2, "1", false},
+	}
+	for _, tt := range tests {
+		err := h.Check(2025, 8, tt.part, tt.answer)
+		if tt.ok != (err == nil) || (err != nil && !errors.Is(err, ErrRefused)) {
+			t.Errorf("Check(part %d, %s) = %v, want ok=%v", tt.part, tt.answer, err, tt.ok)
+		}
+	}
+}
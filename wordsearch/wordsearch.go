@@ -0,0 +1,122 @@
+// Package wordsearch finds words in a grid of letters, as in 2024 day 4.
+// A word may run in any of the eight directions, including backwards, and
+// occurrences may overlap and share letters.
+package wordsearch
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+// Match is one occurrence of a word.
+type Match struct {
+	// Word is the index of the word in the list searched for.
+	Word int
+
+	// Start is the cell holding the first letter and Dir the step from
+	// each letter to the next.
+	Start, Dir grid.Point
+
+	// Len is the length of the word.
+	Len int
+}
+
+// Cell returns the cell holding letter i of the word.
+func (m Match) Cell(i int) grid.Point {
+	return m.Start.Add(m.Dir.Mul(i))
+}
+
+// Find returns every occurrence of the words in g running in one of dirs,
+// such as grid.Dirs8[:], ordered by start cell, then by the order of dirs,
+// then by word. A word that reads the same both ways is found once in each
+// direction.
+func Find(g *grid.Grid[byte], words []string, dirs []grid.Point) []Match {
+	// Only the words starting with a cell's letter can start there.
+	byFirst := make(map[byte][]int)
+	for i, w := range words {
+		if w != "" {
+			byFirst[w[0]] = append(byFirst[w[0]], i)
+		}
+	}
+	var matches []Match
+	g.Each(func(p grid.Point, ch byte) {
+		candidates := byFirst[ch]
+		if len(candidates) == 0 {
+			return
+		}
+		for _, d := range dirs {
+			for _, i := range candidates {
+				if reads(g, words[i], p, d) {
+					matches = append(matches, Match{i, p, d, len(words[i])})
+				}
+			}
+		}
+	})
+	return matches
+}
+
+// reads reports whether word runs from p in direction d.
+func reads(g *grid.Grid[byte], word string, p, d grid.Point) bool {
+	for i := 0; i < len(word); i++ {
+		if ch, ok := g.Get(p); !ok || ch != word[i] {
+			return false
+		}
+		p = p.Add(d)
+	}
+	return true
+}
+
+// Cross is two words crossing diagonally at their middle letters, one along
+// each diagonal.
+type Cross struct {
+	Center grid.Point
+
+	// Down runs along the diagonal from top left to bottom right, either
+	// way; Up along the one from bottom left to top right.
+	Down, Up Match
+}
+
+// diagonals holds the directions along the two diagonals.
+var diagonals = [2][2]grid.Point{
+	{grid.Down.Add(grid.Right), grid.Up.Add(grid.Left)},
+	{grid.Up.Add(grid.Right), grid.Down.Add(grid.Left)},
+}
+
+// Crosses returns every cross of two of the words, ordered by centre. The
+// words must have odd lengths of at least three, so that each has a middle
+// letter and the two arms are distinct. Every pairing counts: a centre with
+// two matches on each diagonal makes four crosses.
+func Crosses(g *grid.Grid[byte], words []string) ([]Cross, error) {
+	for _, w := range words {
+		if len(w)%2 == 0 || len(w) < 3 {
+			return nil, fmt.Errorf("word %q has no middle letter to cross at", w)
+		}
+	}
+	var centres []grid.Point
+	arms := make(map[grid.Point]*[2][]Match)
+	for axis, dirs := range diagonals {
+		for _, m := range Find(g, words, dirs[:]) {
+			c := m.Cell(m.Len / 2)
+			if arms[c] == nil {
+				arms[c] = new([2][]Match)
+				centres = append(centres, c)
+			}
+			arms[c][axis] = append(arms[c][axis], m)
+		}
+	}
+	slices.SortFunc(centres, func(a, b grid.Point) int {
+		return cmp.Or(cmp.Compare(a.R, b.R), cmp.Compare(a.C, b.C))
+	})
+	var crosses []Cross
+	for _, c := range centres {
+		for _, down := range arms[c][0] {
+			for _, up := range arms[c][1] {
+				crosses = append(crosses, Cross{c, down, up})
+			}
+		}
+	}
+	return crosses, nil
+}
@@ -0,0 +1,71 @@
+package wordsearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shubhamsugara22/AdventOfCode-202X/grid"
+)
+
+func mustGrid(t *testing.T, rows string) *grid.Grid[byte] {
+	t.Helper()
+	g, err := grid.ParseLines(strings.Split(rows, "/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestFind(t *testing.T) {
+	g := mustGrid(t, "CAT/ATA/TAC")
+	got := Find(g, []string{"CAT", "TA"}, grid.Dirs8[:])
+	want := []Match{
+		{0, grid.Point{R: 0, C: 0}, grid.Right, 3},
+		{0, grid.Point{R: 0, C: 0}, grid.Down, 3},
+		{1, grid.Point{R: 0, C: 2}, grid.Down, 2},
+		{1, grid.Point{R: 0, C: 2}, grid.Left, 2},
+		{1, grid.Point{R: 1, C: 1}, grid.Up, 2},
+		{1, grid.Point{R: 1, C: 1}, grid.Right, 2},
+		{1, grid.Point{R: 1, C: 1}, grid.Down, 2},
+		{1, grid.Point{R: 1, C: 1}, grid.Left, 2},
+		{1, grid.Point{R: 2, C: 0}, grid.Up, 2},
+		{1, grid.Point{R: 2, C: 0}, grid.Right, 2},
+		{0, grid.Point{R: 2, C: 2}, grid.Up, 3},
+		{0, grid.Point{R: 2, C: 2}, grid.Left, 3},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("found %d matches, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("match %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if c := got[1].Cell(2); c != (grid.Point{R: 2, C: 0}) {
+		t.Errorf("last letter of the second match at %v", c)
+	}
+}
+
+func TestCrosses(t *testing.T) {
+	// Two five-letter words crossing at their middle letter.
+	g := mustGrid(t, "L...S/.E.T./..V../.O.E./R...L")
+	crosses, err := Crosses(g, []string{"LEVEL", "STVOR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// LEVEL reads both ways along the main diagonal, and STVOR runs down
+	// the other one from the top right.
+	if len(crosses) != 2 {
+		t.Fatalf("found %d crosses, want 2: %+v", len(crosses), crosses)
+	}
+	for _, c := range crosses {
+		if c.Center != (grid.Point{R: 2, C: 2}) || c.Up.Word != 1 || c.Down.Word != 0 {
+			t.Errorf("unexpected cross %+v", c)
+		}
+	}
+	for _, word := range []string{"XMAS", "A", ""} {
+		if _, err := Crosses(g, []string{word}); err == nil {
+			t.Errorf("Crosses accepted %q", word)
+		}
+	}
+}